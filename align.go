@@ -0,0 +1,287 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"math"
+)
+
+// Sequence comparison of slices, treating each element as a token.
+
+// EditDistance returns the Levenshtein distance between a and b: the fewest
+// element insertions, deletions and substitutions that change a into b.
+//
+//	EditDistance([]string{"git", "commit", "-m"}, []string{"git", "commit", "--amend"}) // 1
+func EditDistance(a, b []string) int {
+	return int(EditDistanceFunc(a, b, nil))
+}
+
+// EditDistanceFunc returns the weighted edit distance between a and b, where an
+// insertion or deletion costs 1 and replacing x by y costs sub(x, y). Func sub is
+// called for every pair of elements, and should return 0 for equal elements and
+// a non-negative cost otherwise. If sub is nil, a substitution costs 1.
+func EditDistanceFunc(a, b []string, sub func(x, y string) float64) float64 {
+	if sub == nil {
+		sub = unitCost
+	}
+
+	prev := make([]float64, len(b)+1)
+	cur := make([]float64, len(b)+1)
+	for j := range prev {
+		prev[j] = float64(j)
+	}
+
+	for i := range a {
+		cur[0] = float64(i + 1)
+		for j := range b {
+			cur[j+1] = math.Min(
+				prev[j]+sub(a[i], b[j]),
+				math.Min(prev[j+1], cur[j])+1,
+			)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
+
+// unitCost is the substitution cost of EditDistance.
+func unitCost(x, y string) float64 {
+	if x == y {
+		return 0
+	}
+
+	return 1
+}
+
+// matchScore is the default score of AlignGlobal and AlignLocal.
+func matchScore(x, y string) float64 {
+	if x == y {
+		return 1
+	}
+
+	return -1
+}
+
+// AlignedPair is a pair of elements in an alignment of two slices. I and J are
+// the indexes of the elements in the first and second slice; a gap in either
+// slice has index -1 and an empty element.
+type AlignedPair struct {
+	I, J int
+	A, B string
+}
+
+// alignment is the score matrix of an alignment of a and b.
+type alignment struct {
+	a, b  []string
+	score func(x, y string) float64
+	gap   float64
+	h     [][]float64
+}
+
+// newAlignment returns the alignment of a and b with the score matrix filled in.
+// If local is true, scores don't go below 0, as in Smith-Waterman.
+func newAlignment(a, b []string, score func(x, y string) float64, gap float64, local bool) *alignment {
+	if score == nil {
+		score = matchScore
+	}
+
+	al := &alignment{a: a, b: b, score: score, gap: gap, h: make([][]float64, len(a)+1)}
+	for i := range al.h {
+		al.h[i] = make([]float64, len(b)+1)
+	}
+
+	// The borders are summed the same way traceback checks them, so that the
+	// float comparisons there are exact.
+	if !local {
+		for i := 1; i <= len(a); i++ {
+			al.h[i][0] = al.h[i-1][0] + gap
+		}
+		for j := 1; j <= len(b); j++ {
+			al.h[0][j] = al.h[0][j-1] + gap
+		}
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			v := math.Max(
+				al.h[i-1][j-1]+score(a[i-1], b[j-1]),
+				math.Max(al.h[i-1][j], al.h[i][j-1])+gap,
+			)
+			if local && v < 0 {
+				v = 0
+			}
+			al.h[i][j] = v
+		}
+	}
+
+	return al
+}
+
+// traceback returns the aligned pairs that end at cell i, j of the score matrix.
+// If local is true, the alignment stops at the first cell with score 0, otherwise
+// it goes back to the start of both slices.
+func (al *alignment) traceback(i, j int, local bool) []AlignedPair {
+	var pairs []AlignedPair
+
+	for i > 0 || j > 0 {
+		if local && al.h[i][j] == 0 {
+			break
+		}
+
+		h := al.h[i][j]
+		switch {
+		case j == 0:
+			i--
+			pairs = append(pairs, AlignedPair{I: i, J: -1, A: al.a[i]})
+		case i == 0:
+			j--
+			pairs = append(pairs, AlignedPair{I: -1, J: j, B: al.b[j]})
+		case h == al.h[i-1][j-1]+al.score(al.a[i-1], al.b[j-1]):
+			i, j = i-1, j-1
+			pairs = append(pairs, AlignedPair{I: i, J: j, A: al.a[i], B: al.b[j]})
+		case h == al.h[i-1][j]+al.gap:
+			i--
+			pairs = append(pairs, AlignedPair{I: i, J: -1, A: al.a[i]})
+		default:
+			j--
+			pairs = append(pairs, AlignedPair{I: -1, J: j, B: al.b[j]})
+		}
+	}
+
+	for l, r := 0, len(pairs)-1; l < r; l, r = l+1, r-1 {
+		pairs[l], pairs[r] = pairs[r], pairs[l]
+	}
+
+	return pairs
+}
+
+// AlignGlobal returns the best alignment of all of a with all of b using the
+// Needleman-Wunsch algorithm, and its score. The score of aligning x with y is
+// score(x, y), and gap is added for each gap, so it is usually negative.
+// If score is nil, a match scores 1 and a mismatch -1.
+//
+// Where several alignments have the best score, matches are preferred to gaps,
+// and gaps in b to gaps in a, working back from the end.
+//
+//	AlignGlobal([]string{"a", "b", "c"}, []string{"a", "c"}, nil, -1)
+//	// [{0 0 a a} {1 -1 b } {2 1 c c}], 1
+func AlignGlobal(a, b []string, score func(x, y string) float64, gap float64) ([]AlignedPair, float64) {
+	al := newAlignment(a, b, score, gap, false)
+
+	return al.traceback(len(a), len(b), false), al.h[len(a)][len(b)]
+}
+
+// AlignLocal returns the best alignment of a part of a with a part of b using the
+// Smith-Waterman algorithm, and its score. The arguments are as for AlignGlobal.
+// If no part of a and b scores above 0, it returns nil and 0.
+func AlignLocal(a, b []string, score func(x, y string) float64, gap float64) ([]AlignedPair, float64) {
+	al := newAlignment(a, b, score, gap, true)
+
+	var besti, bestj int
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if al.h[i][j] > al.h[besti][bestj] {
+				besti, bestj = i, j
+			}
+		}
+	}
+
+	return al.traceback(besti, bestj, true), al.h[besti][bestj]
+}
+
+// Ratio returns a measure of the similarity of a and b between 0 and 1, like
+// Python's difflib.SequenceMatcher(None, a, b).ratio(). It is 2*M/T, where T is
+// the total number of elements in a and b and M the number of elements in the
+// matching blocks found by the Ratcliff/Obershelp algorithm. Two empty slices
+// have a ratio of 1.
+//
+// As in difflib, if b has 200 or more elements, elements that make up more than
+// 1% of b are not used to start a matching block.
+func Ratio(a, b []string) float64 {
+	t := len(a) + len(b)
+	if t == 0 {
+		return 1
+	}
+
+	return 2 * float64(matchingElements(a, b)) / float64(t)
+}
+
+// matchingElements returns the number of elements in the matching blocks of a and b,
+// following difflib.SequenceMatcher.get_matching_blocks.
+func matchingElements(a, b []string) int {
+	b2j := make(map[string][]int)
+	for j, v := range b {
+		b2j[v] = append(b2j[v], j)
+	}
+	if n := len(b); n >= 200 {
+		popular := n/100 + 1
+		for v, js := range b2j {
+			if len(js) > popular {
+				delete(b2j, v)
+			}
+		}
+	}
+
+	var (
+		total int
+		queue = [][4]int{{0, len(a), 0, len(b)}}
+	)
+
+	for len(queue) > 0 {
+		q := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		alo, ahi, blo, bhi := q[0], q[1], q[2], q[3]
+
+		i, j, k := longestMatch(a, b, b2j, alo, ahi, blo, bhi)
+		if k == 0 {
+			continue
+		}
+		total += k
+
+		if alo < i && blo < j {
+			queue = append(queue, [4]int{alo, i, blo, j})
+		}
+		if i+k < ahi && j+k < bhi {
+			queue = append(queue, [4]int{i + k, ahi, j + k, bhi})
+		}
+	}
+
+	return total
+}
+
+// longestMatch returns the longest matching block in a[alo:ahi] and b[blo:bhi],
+// as i, j and its size k, following difflib.SequenceMatcher.find_longest_match.
+func longestMatch(a, b []string, b2j map[string][]int, alo, ahi, blo, bhi int) (int, int, int) {
+	besti, bestj, bestk := alo, blo, 0
+
+	j2len := make(map[int]int)
+	for i := alo; i < ahi; i++ {
+		next := make(map[int]int)
+		for _, j := range b2j[a[i]] {
+			if j < blo {
+				continue
+			}
+			if j >= bhi {
+				break
+			}
+			k := j2len[j-1] + 1
+			next[j] = k
+			if k > bestk {
+				besti, bestj, bestk = i-k+1, j-k+1, k
+			}
+		}
+		j2len = next
+	}
+
+	// Extend the block with the elements left out of b2j.
+	for besti > alo && bestj > blo && a[besti-1] == b[bestj-1] {
+		besti, bestj, bestk = besti-1, bestj-1, bestk+1
+	}
+	for besti+bestk < ahi && bestj+bestk < bhi && a[besti+bestk] == b[bestj+bestk] {
+		bestk++
+	}
+
+	return besti, bestj, bestk
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		out  int
+	}{
+		{a: "", b: "", out: 0},
+		{a: "a b c", b: "", out: 3},
+		{a: "", b: "a b", out: 2},
+		{a: "git commit -m", b: "git commit --amend", out: 1},
+		{a: "k i t t e n", b: "s i t t i n g", out: 3},
+		{a: "a b c d", b: "b c d a", out: 2},
+	}
+	for _, tc := range tests {
+		a, b := strings.Fields(tc.a), strings.Fields(tc.b)
+		if out := EditDistance(a, b); out != tc.out {
+			t.Errorf("EditDistance(%q, %q) = %v, want %v", a, b, out, tc.out)
+		}
+		if out := EditDistance(b, a); out != tc.out {
+			t.Errorf("EditDistance(%q, %q) = %v, want %v", b, a, out, tc.out)
+		}
+	}
+}
+
+func TestEditDistanceFunc(t *testing.T) {
+	// Substituting a flag for another flag is cheap.
+	sub := func(x, y string) float64 {
+		switch {
+		case x == y:
+			return 0
+		case strings.HasPrefix(x, "-") && strings.HasPrefix(y, "-"):
+			return 0.25
+		}
+		return 1
+	}
+
+	tests := []struct {
+		a, b string
+		out  float64
+	}{
+		{a: "ls -l -a", b: "ls -l -h", out: 0.25},
+		{a: "ls -l -a", b: "ls -l dir", out: 1},
+		{a: "ls -a", b: "ls", out: 1},
+		{a: "-a -b", b: "x y", out: 2},
+	}
+	for _, tc := range tests {
+		a, b := strings.Fields(tc.a), strings.Fields(tc.b)
+		if out := EditDistanceFunc(a, b, sub); out != tc.out {
+			t.Errorf("EditDistanceFunc(%q, %q) = %v, want %v", a, b, out, tc.out)
+		}
+	}
+
+	// Substitutions costing more than a deletion and an insertion are never used.
+	a, b := []string{"a"}, []string{"b"}
+	if out := EditDistanceFunc(a, b, func(x, y string) float64 { return 5 }); out != 2 {
+		t.Errorf("EditDistanceFunc() = %v, want 2", out)
+	}
+}
+
+// alignString returns pairs as "a/b" tokens, with "-" for gaps.
+func alignString(pairs []AlignedPair) string {
+	s := make([]string, len(pairs))
+	for k, p := range pairs {
+		x, y := p.A, p.B
+		if p.I < 0 {
+			x = "-"
+		}
+		if p.J < 0 {
+			y = "-"
+		}
+		s[k] = x + "/" + y
+	}
+
+	return strings.Join(s, " ")
+}
+
+func TestAlignGlobal(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		out   string
+		score float64
+	}{
+		{a: "", b: "", out: "", score: 0},
+		{a: "a b c", b: "a c", out: "a/a b/- c/c", score: 1},
+		{a: "a c", b: "a b c", out: "a/a -/b c/c", score: 1},
+		{a: "G A T T A C A", b: "G C A T G C U", out: "G/G -/C A/A T/- T/T A/G C/C A/U", score: 0},
+		{a: "x", b: "", out: "x/-", score: -1},
+	}
+	for _, tc := range tests {
+		a, b := strings.Fields(tc.a), strings.Fields(tc.b)
+		pairs, score := AlignGlobal(a, b, nil, -1)
+		if out := alignString(pairs); out != tc.out || score != tc.score {
+			t.Errorf("AlignGlobal(%q, %q) = %q, %v; want %q, %v", a, b, out, score, tc.out, tc.score)
+		}
+	}
+
+	pairs, _ := AlignGlobal([]string{"a", "b"}, []string{"b"}, nil, -1)
+	want := []AlignedPair{{I: 0, J: -1, A: "a"}, {I: 1, J: 0, A: "b", B: "b"}}
+	if !reflect.DeepEqual(pairs, want) {
+		t.Errorf("AlignGlobal() = %+v, want %+v", pairs, want)
+	}
+
+	// A gap that isn't exactly representable must not derail the traceback.
+	a := Repeat("x", 6)
+	for _, tc := range []struct{ a, b []string }{{a, nil}, {nil, a}} {
+		pairs, score := AlignGlobal(tc.a, tc.b, nil, -0.1)
+		if len(pairs) != 6 || math.Abs(score+0.6) > 1e-9 {
+			t.Errorf("AlignGlobal(%q, %q, -0.1) = %+v, %v", tc.a, tc.b, pairs, score)
+		}
+	}
+}
+
+func TestAlignLocal(t *testing.T) {
+	score := func(x, y string) float64 {
+		if x == y {
+			return 3
+		}
+		return -3
+	}
+
+	tests := []struct {
+		a, b  string
+		out   string
+		score float64
+	}{
+		{a: "T G T T A C G G", b: "G G T T G A C T A", out: "G/G T/T T/T -/G A/A C/C", score: 13},
+		{a: "x y", b: "a b", out: "", score: 0},
+		{a: "please run the tests now", b: "run the tests", out: "run/run the/the tests/tests", score: 9},
+	}
+	for _, tc := range tests {
+		a, b := strings.Fields(tc.a), strings.Fields(tc.b)
+		pairs, s := AlignLocal(a, b, score, -2)
+		if out := alignString(pairs); out != tc.out || s != tc.score {
+			t.Errorf("AlignLocal(%q, %q) = %q, %v; want %q, %v", a, b, out, s, tc.out, tc.score)
+		}
+	}
+}
+
+func TestRatio(t *testing.T) {
+	seq := func(n, mod int) []string {
+		a := make([]string, n)
+		for i := range a {
+			a[i] = strconv.Itoa(i % mod)
+		}
+		return a
+	}
+
+	// The expected values are from Python's difflib.SequenceMatcher.ratio.
+	tests := []struct {
+		a, b []string
+		out  float64
+	}{
+		{a: nil, b: nil, out: 1},
+		{a: []string{"a"}, b: nil, out: 0},
+		{a: strings.Fields("a b c d"), b: strings.Fields("a x c d"), out: 0.75},
+		{a: strings.Fields("the quick brown fox jumps"), b: strings.Fields("the quick red fox jumped over"), out: 0.5454545454545454},
+		{a: strings.Fields("git commit -m msg"), b: strings.Fields("git commit --amend -m msg"), out: 0.8888888888888888},
+		{a: seq(250, 7), b: seq(300, 5), out: 0.01818181818181818},
+		{a: append(seq(40, 3), "z"), b: append(seq(220, 11), "z"), out: 0.030534351145038167},
+	}
+	for _, tc := range tests {
+		if out := Ratio(tc.a, tc.b); math.Abs(out-tc.out) > 1e-12 {
+			t.Errorf("Ratio(%.20q, %.20q) = %v, want %v", tc.a, tc.b, out, tc.out)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+// Copy and alias contract
+//
+// Functions that return a []string fall in one of three groups:
+//
+//   - Copy: the result never shares memory with the arguments, and the arguments
+//     are not modified. This is the default; for example Diff, Filter, Map, Slice
+//     and Splice.
+//   - Alias: the result shares the backing array of an argument, but the arguments
+//     are not modified. These are Chunk, the Fields and Split functions, whose
+//     subslices have their capacity limited, and SliceView. Map and TrimFunc with
+//     a nil func, and Replace when there is nothing to replace, return the
+//     argument itself.
+//   - Mutate: the function changes its argument. These are Reverse, Shuffle and
+//     Unique, InsertAt when the result fits in the capacity of its argument, the
+//     InPlace functions, and Pop, Push, Shift, Unshift, WalkRef and WalkRecursive.
+//
+// Building with the slicesaudit tag checks the Copy functions at run time: they
+// panic if their result shares memory with an argument, or if an argument was
+// modified.
+//
+//	go test -tags slicesaudit ./...
+
+// overlaps returns true if the backing arrays of a and b overlap, up to their capacity.
+func overlaps(a, b []string) bool {
+	if cap(a) == 0 || cap(b) == 0 {
+		return false
+	}
+
+	a, b = a[:cap(a)], b[:cap(b)]
+	for i := range a {
+		if &a[i] == &b[0] {
+			return true
+		}
+	}
+	for i := range b {
+		if &b[i] == &a[0] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,15 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+//go:build !slicesaudit
+// +build !slicesaudit
+
+package slices
+
+// auditEnabled reports whether the Copy functions check their contract.
+const auditEnabled = false
+
+// audit is a no-op without the slicesaudit build tag.
+func audit(string, ...[]string) func(*[]string) {
+	return func(*[]string) {}
+}
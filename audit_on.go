@@ -0,0 +1,35 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+//go:build slicesaudit
+// +build slicesaudit
+
+package slices
+
+import (
+	"fmt"
+)
+
+// auditEnabled reports whether the Copy functions check their contract.
+const auditEnabled = true
+
+// audit saves a copy of the arguments of the function name, and returns a func
+// that panics if the result pointed by res shares memory with an argument, or if
+// an argument was modified. The returned func is meant to be deferred.
+func audit(name string, args ...[]string) func(res *[]string) {
+	saved := make([][]string, len(args))
+	for i, a := range args {
+		saved[i] = append([]string(nil), a[:cap(a)]...)
+	}
+
+	return func(res *[]string) {
+		for i, a := range args {
+			if overlaps(*res, a) {
+				panic(fmt.Sprintf("slices: %s result shares memory with argument %d", name, i+1))
+			}
+			if !Equal(a[:cap(a)], saved[i]) {
+				panic(fmt.Sprintf("slices: %s modified argument %d", name, i+1))
+			}
+		}
+	}
+}
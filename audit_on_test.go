@@ -0,0 +1,48 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+//go:build slicesaudit
+// +build slicesaudit
+
+package slices
+
+import (
+	"testing"
+)
+
+func TestAudit(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(a []string) []string
+		msg  string
+	}{
+		{name: "ok", f: func(a []string) []string { return append([]string(nil), a...) }},
+		{name: "alias", f: func(a []string) []string { return a[1:] },
+			msg: "slices: test result shares memory with argument 1"},
+		{name: "mutate", f: func(a []string) []string { a[0] = "x"; return nil },
+			msg: "slices: test modified argument 1"},
+		{name: "spare", f: func(a []string) []string { _ = append(a[:1], "x"); return nil },
+			msg: "slices: test modified argument 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var msg string
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						msg = r.(string)
+					}
+				}()
+
+				a := []string{"a", "b"}
+				check := audit("test", a)
+				out := tt.f(a)
+				check(&out)
+			}()
+			if msg != tt.msg {
+				t.Errorf("panic = %q, want %q", msg, tt.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+// Bag is a multiset of strings: an unordered collection that counts how many
+// times each value was added. Unlike the set operations Diff and Intersect,
+// Bag operations honour those counts.
+//
+// The zero value is an empty bag ready to use. A Bag is not safe for concurrent use.
+type Bag struct {
+	counts   map[string]int // removed values are kept with count 0 until compacted
+	order    []string       // values in counts, in order of first insertion
+	distinct int            // number of values with count > 0
+	n        int            // sum of counts
+}
+
+// NewBag returns a Bag with the elements of a.
+func NewBag(a []string) *Bag {
+	b := &Bag{counts: make(map[string]int, len(a))}
+	b.Add(a...)
+
+	return b
+}
+
+// Add adds one instance of each value to the bag.
+func (b *Bag) Add(values ...string) {
+	for _, v := range values {
+		b.AddN(v, 1)
+	}
+}
+
+// AddN adds n instances of s to the bag. It panics if n is negative.
+func (b *Bag) AddN(s string, n int) {
+	if n < 0 {
+		panic("slices: negative Bag count")
+	}
+	if n == 0 {
+		return
+	}
+
+	if b.counts == nil {
+		b.counts = make(map[string]int)
+	}
+
+	c, ok := b.counts[s]
+	if !ok {
+		b.order = append(b.order, s)
+	}
+	if c == 0 {
+		b.distinct++
+	}
+	b.counts[s] = c + n
+	b.n += n
+}
+
+// Remove removes one instance of s from the bag, and returns true if s was in the bag.
+func (b *Bag) Remove(s string) bool {
+	return b.RemoveN(s, 1) == 1
+}
+
+// RemoveN removes up to n instances of s from the bag, and returns the number removed.
+func (b *Bag) RemoveN(s string, n int) int {
+	c := b.counts[s]
+	if n > c {
+		n = c
+	}
+	if n <= 0 {
+		return 0
+	}
+
+	b.counts[s] = c - n
+	b.n -= n
+	if c == n {
+		b.distinct--
+		b.compact()
+	}
+
+	return n
+}
+
+// compact forgets the values that were removed from the bag, once they are
+// more than half of the values remembered.
+func (b *Bag) compact() {
+	if len(b.order) < 2*b.distinct+16 {
+		return
+	}
+
+	order := b.order[:0]
+	for _, v := range b.order {
+		if b.counts[v] > 0 {
+			order = append(order, v)
+		} else {
+			delete(b.counts, v)
+		}
+	}
+	b.order = clearTail(b.order, len(order))
+}
+
+// Count returns the number of instances of s in the bag.
+func (b *Bag) Count(s string) int {
+	return b.counts[s]
+}
+
+// Distinct returns the number of distinct values in the bag.
+func (b *Bag) Distinct() int {
+	return b.distinct
+}
+
+// Equal returns true if b and o have the same values with the same counts.
+func (b *Bag) Equal(o *Bag) bool {
+	if b.n != o.n || b.distinct != o.distinct {
+		return false
+	}
+
+	for v, c := range b.counts {
+		if c > 0 && o.counts[v] != c {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Len returns the number of values in the bag, counting duplicates.
+func (b *Bag) Len() int {
+	return b.n
+}
+
+// Strings returns the values in the bag, each repeated by its count, in order of
+// first insertion.
+func (b *Bag) Strings() []string {
+	if b.n == 0 {
+		return nil
+	}
+
+	a := make([]string, 0, b.n)
+	for _, v := range b.order {
+		for i := b.counts[v]; i > 0; i-- {
+			a = append(a, v)
+		}
+	}
+
+	return a
+}
+
+// Diff returns a new bag with the counts of b minus those of o.
+func (b *Bag) Diff(o *Bag) *Bag {
+	return b.combine(o, func(x, y int) int { return x - y })
+}
+
+// Intersect returns a new bag with the lower of the counts of b and o.
+func (b *Bag) Intersect(o *Bag) *Bag {
+	return b.combine(o, func(x, y int) int {
+		if y < x {
+			return y
+		}
+		return x
+	})
+}
+
+// Sum returns a new bag with the counts of b and o added together.
+func (b *Bag) Sum(o *Bag) *Bag {
+	return b.combine(o, func(x, y int) int { return x + y })
+}
+
+// Union returns a new bag with the higher of the counts of b and o.
+func (b *Bag) Union(o *Bag) *Bag {
+	return b.combine(o, func(x, y int) int {
+		if y > x {
+			return y
+		}
+		return x
+	})
+}
+
+// combine returns a new bag with the counts f(x, y) for each value in b or o,
+// where x and y are the counts in b and o. Values in b come first.
+func (b *Bag) combine(o *Bag, f func(x, y int) int) *Bag {
+	nb := &Bag{counts: make(map[string]int)}
+
+	for _, v := range b.order {
+		if n := f(b.counts[v], o.counts[v]); n > 0 {
+			nb.AddN(v, n)
+		}
+	}
+	for _, v := range o.order {
+		if _, ok := b.counts[v]; ok {
+			continue
+		}
+		if n := f(0, o.counts[v]); n > 0 {
+			nb.AddN(v, n)
+		}
+	}
+
+	return nb
+}
+
+// MultisetDiff returns a slice with the elements of a, where each element of b
+// cancels one instance of an equal element in a. The first instances are removed.
+//
+//	MultisetDiff([]string{"a", "a", "b"}, []string{"a"}) // ["a", "b"]
+func MultisetDiff(a, b []string) []string {
+	rm := NewBag(b)
+
+	return FilterFunc(a, func(v string) bool {
+		return !rm.Remove(v)
+	})
+}
+
+// MultisetIntersect returns a slice with the elements of a, each kept only as many
+// times as it appears in b.
+//
+//	MultisetIntersect([]string{"a", "a", "b"}, []string{"a", "c"}) // ["a"]
+func MultisetIntersect(a, b []string) []string {
+	keep := NewBag(b)
+
+	return FilterFunc(a, keep.Remove)
+}
+
+// MultisetUnion returns a slice with the elements of a, followed by the elements of b
+// that exceed the number of times they appear in a.
+//
+//	MultisetUnion([]string{"a", "a", "b"}, []string{"a", "b", "b"}) // ["a", "a", "b", "b"]
+func MultisetUnion(a, b []string) []string {
+	have := NewBag(a)
+
+	return Merge(a, FilterFunc(b, func(v string) bool {
+		return !have.Remove(v)
+	}))
+}
+
+// SameElements returns true if a and b have the same elements with the same number
+// of instances, in any order.
+func SameElements(a, b []string) bool {
+	return len(a) == len(b) && NewBag(a).Equal(NewBag(b))
+}
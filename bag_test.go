@@ -0,0 +1,122 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBag(t *testing.T) {
+	b := NewBag([]string{"a", "b", "a", "c", "a"})
+	if b.Len() != 5 || b.Distinct() != 3 || b.Count("a") != 3 || b.Count("x") != 0 {
+		t.Errorf("Len() = %v, Distinct() = %v, Count(a) = %v", b.Len(), b.Distinct(), b.Count("a"))
+	}
+	if got := b.Strings(); !Equal(got, []string{"a", "a", "a", "b", "c"}) {
+		t.Errorf("Strings() = %q", got)
+	}
+
+	if !b.Remove("b") || b.Remove("b") || b.Distinct() != 2 {
+		t.Errorf("Remove() = %q", b.Strings())
+	}
+	if n := b.RemoveN("a", 5); n != 3 || b.Len() != 1 {
+		t.Errorf("RemoveN() = %v, Strings() = %q", n, b.Strings())
+	}
+	b.Add("b", "a")
+	if got := b.Strings(); !Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("Strings() after re-Add = %q", got)
+	}
+
+	var zero Bag
+	if zero.Len() != 0 || zero.Strings() != nil || zero.Remove("a") {
+		t.Error("zero Bag is not empty")
+	}
+	zero.AddN("x", 2)
+	if zero.Count("x") != 2 {
+		t.Errorf("AddN() = %q", zero.Strings())
+	}
+}
+
+func TestBagCompact(t *testing.T) {
+	var b Bag
+	for i := 0; i < 100; i++ {
+		b.Add(fmt.Sprint(i))
+	}
+	for i := 0; i < 99; i++ {
+		b.Remove(fmt.Sprint(i))
+	}
+	if len(b.order) >= 50 || b.Distinct() != 1 || !Equal(b.Strings(), []string{"99"}) {
+		t.Errorf("order = %d, Strings() = %q", len(b.order), b.Strings())
+	}
+}
+
+func TestBagOps(t *testing.T) {
+	x := NewBag([]string{"a", "a", "b", "c"})
+	y := NewBag([]string{"a", "b", "b", "d"})
+
+	tests := []struct {
+		name string
+		out  *Bag
+		want []string
+	}{
+		{name: "Diff", out: x.Diff(y), want: []string{"a", "c"}},
+		{name: "Intersect", out: x.Intersect(y), want: []string{"a", "b"}},
+		{name: "Union", out: x.Union(y), want: []string{"a", "a", "b", "b", "c", "d"}},
+		{name: "Sum", out: x.Sum(y), want: []string{"a", "a", "a", "b", "b", "b", "c", "d"}},
+	}
+	for _, tt := range tests {
+		if got := tt.out.Strings(); !Equal(got, tt.want) {
+			t.Errorf("%s() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	if !x.Equal(NewBag([]string{"c", "a", "b", "a"})) || x.Equal(y) {
+		t.Error("Equal() failed")
+	}
+}
+
+func TestMultiset(t *testing.T) {
+	tests := []struct {
+		a, b                   []string
+		diff, intersect, union []string
+	}{
+		{a: []string{"a", "a"}, b: []string{"a"},
+			diff: []string{"a"}, intersect: []string{"a"}, union: []string{"a", "a"}},
+		{a: []string{"a", "b", "a", "c"}, b: []string{"a", "c", "c", "d"},
+			diff: []string{"b", "a"}, intersect: []string{"a", "c"}, union: []string{"a", "b", "a", "c", "c", "d"}},
+		{a: nil, b: []string{"a"},
+			diff: nil, intersect: nil, union: []string{"a"}},
+		{a: []string{"a"}, b: nil,
+			diff: []string{"a"}, intersect: nil, union: []string{"a"}},
+	}
+	for _, tc := range tests {
+		if out := MultisetDiff(tc.a, tc.b); !Equal(out, tc.diff) {
+			t.Errorf("MultisetDiff(%q, %q) = %q, want %q", tc.a, tc.b, out, tc.diff)
+		}
+		if out := MultisetIntersect(tc.a, tc.b); !Equal(out, tc.intersect) {
+			t.Errorf("MultisetIntersect(%q, %q) = %q, want %q", tc.a, tc.b, out, tc.intersect)
+		}
+		if out := MultisetUnion(tc.a, tc.b); !Equal(out, tc.union) {
+			t.Errorf("MultisetUnion(%q, %q) = %q, want %q", tc.a, tc.b, out, tc.union)
+		}
+	}
+}
+
+func TestSameElements(t *testing.T) {
+	tests := []struct {
+		a, b []string
+		out  bool
+	}{
+		{a: nil, b: nil, out: true},
+		{a: []string{"a", "b", "a"}, b: []string{"a", "a", "b"}, out: true},
+		{a: []string{"a", "b", "b"}, b: []string{"a", "a", "b"}, out: false},
+		{a: []string{"a", "b"}, b: []string{"a", "b", "b"}, out: false},
+		{a: []string{""}, b: nil, out: false},
+	}
+	for _, tc := range tests {
+		if out := SameElements(tc.a, tc.b); out != tc.out {
+			t.Errorf("SameElements(%q, %q) = %v, want %v", tc.a, tc.b, out, tc.out)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+#!/bin/sh
+# Copyright (c) 2025 srfrog - https://srfrog.dev
+# Use of this source code is governed by the license in the LICENSE file.
+#
+# compare.sh runs the size benchmarks and compares them with benchstat.
+#
+# usage: bench/compare.sh [-u] [-r ref] [regexp]
+#
+#   -u      update bench/baseline.txt instead of comparing
+#   -r ref  compare against the git ref (for example master) instead of the
+#           baseline, running both on this machine
+#   regexp  the benchmarks to run, default "Sizes"
+#
+# The script exits with status 1 if Diff, Unique or Split got slower or use more
+# memory by more than THRESHOLD percent (default 10). The baseline depends on
+# the machine, so prefer -r when comparing on another machine. The ref must have
+# the size benchmarks and their -bench.max flag.
+#
+# Environment: COUNT (default 6), BENCHTIME (default 100ms), BENCHMAX (default 1000000),
+# THRESHOLD (default 10).
+
+set -eu
+
+cd "$(dirname "$0")/.."
+
+update=
+ref=
+while getopts ur: opt; do
+	case $opt in
+	u) update=1 ;;
+	r) ref=$OPTARG ;;
+	*) sed -n '7,13s/^# \{0,1\}//p' "$0" >&2; exit 2 ;;
+	esac
+done
+shift $((OPTIND - 1))
+
+pattern=${1:-Sizes}
+count=${COUNT:-6}
+benchtime=${BENCHTIME:-100ms}
+benchmax=${BENCHMAX:-1000000}
+threshold=${THRESHOLD:-10}
+
+# bench runs the benchmarks in directory $1.
+bench() {
+	(cd "$1" && go test -run '^$' -bench "$pattern" -benchmem -timeout 0 \
+		-count "$count" -benchtime "$benchtime" -bench.max "$benchmax" .)
+}
+
+if [ -n "$update" ]; then
+	bench . >bench/baseline.txt
+	exit 0
+fi
+
+if command -v benchstat >/dev/null 2>&1; then
+	benchstat=benchstat
+else
+	benchstat="go run golang.org/x/perf/cmd/benchstat@latest"
+fi
+
+tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"; [ -z "$ref" ] || git worktree remove --force "$tmp/base" 2>/dev/null || true' EXIT
+
+old=bench/baseline.txt
+if [ -n "$ref" ]; then
+	git worktree add --detach "$tmp/base" "$ref" >/dev/null
+	if ! grep -qs '"bench.max"' "$tmp/base/slices_bench_test.go"; then
+		echo "compare.sh: $ref has no size benchmarks to compare against; use a later ref or the baseline" >&2
+		exit 2
+	fi
+	bench "$tmp/base" >"$tmp/old.txt"
+	old=$tmp/old.txt
+fi
+bench . >"$tmp/new.txt"
+
+$benchstat "$old" "$tmp/new.txt" | tee "$tmp/stat.txt"
+
+awk -v max="$threshold" '
+$1 ~ /^Sizes(Diff|Unique|Split)\// && match($0, /\+[0-9.]+%/) {
+	if (substr($0, RSTART + 1, RLENGTH - 2) + 0 > max) {
+		print "regression: " $0
+		bad = 1
+	}
+}
+END { exit bad }
+' "$tmp/stat.txt" >&2
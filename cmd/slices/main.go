@@ -0,0 +1,587 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+// Command slices is a Unix filter that applies the operations of package slices
+// to lines read from stdin or files.
+//
+// Usage:
+//
+//	slices [-0 | -json] [-max-line N] COMMAND [ARGS] [FILE...]
+//
+// Commands:
+//
+//	unique                  remove duplicate lines
+//	diff FILE               lines not found in FILE
+//	intersect FILE          lines also found in FILE
+//	filter [-v] [FILTERS]   lines matching all of -prefix, -suffix, -contains, -regexp
+//	chunk N                 groups of N lines
+//	shuffle [-seed N]       lines in random order
+//	rand [-seed N] N        N randomly selected lines
+//	reverse                 lines in reverse order
+//	split SEP               groups of lines separated by lines equal to SEP
+//	count [-value V]        number of lines, or lines equal to V
+//
+// By default input and output are newline-delimited. With -0 they are NUL-delimited,
+// and with -json they are JSON arrays of strings. Commands that produce groups
+// (chunk and split) separate groups with an empty line, an extra NUL, or write
+// a JSON array of arrays.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/srfrog/slices"
+)
+
+func main() {
+	err := run(os.Args[1:], os.Stdin, os.Stdout)
+	if err != nil && err != flag.ErrHelp {
+		fmt.Fprintln(os.Stderr, "slices:", err)
+		os.Exit(2)
+	}
+}
+
+var errUsage = errors.New("usage: slices [-0 | -json] [-max-line N] COMMAND [ARGS] [FILE...]")
+
+// commands is the list of commands printed by -h.
+const commands = `
+Commands:
+  unique                  remove duplicate lines
+  diff FILE               lines not found in FILE
+  intersect FILE          lines also found in FILE
+  filter [-v] [FILTERS]   lines matching all of -prefix, -suffix, -contains, -regexp
+  chunk N                 groups of N lines
+  shuffle [-seed N]       lines in random order
+  rand [-seed N] N        N randomly selected lines
+  reverse                 lines in reverse order
+  split SEP               groups of lines separated by lines equal to SEP
+  count [-value V]        number of lines, or lines equal to V
+
+Flags:
+`
+
+// format is the delimiting mode for input and output.
+type format int
+
+const (
+	formatLines format = iota
+	formatNull
+	formatJSON
+)
+
+// cli holds the global options shared by all commands.
+type cli struct {
+	format  format
+	maxLine int
+	stdin   io.Reader
+	stdout  io.Writer
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	var (
+		null, jsonMode bool
+		c              = cli{stdin: stdin, stdout: stdout}
+	)
+
+	fs := flag.NewFlagSet("slices", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "%s\n%s", errUsage, commands)
+		fs.PrintDefaults()
+	}
+	fs.BoolVar(&null, "0", false, "NUL-delimited input and output")
+	fs.BoolVar(&jsonMode, "json", false, "JSON array input and output")
+	fs.IntVar(&c.maxLine, "max-line", 0, "maximum line size in bytes")
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+
+	switch {
+	case null && jsonMode:
+		return errors.New("-0 and -json are exclusive")
+	case null:
+		c.format = formatNull
+	case jsonMode:
+		c.format = formatJSON
+	}
+
+	if fs.NArg() == 0 {
+		return errUsage
+	}
+
+	cmd, args := fs.Arg(0), fs.Args()[1:]
+	switch cmd {
+	case "unique":
+		return c.unique(args)
+	case "diff", "intersect":
+		return c.compare(cmd, args)
+	case "filter":
+		return c.filter(args)
+	case "chunk":
+		return c.chunk(args)
+	case "shuffle", "rand":
+		return c.random(cmd, args)
+	case "reverse":
+		return c.reverse(args)
+	case "split":
+		return c.split(args)
+	case "count":
+		return c.count(args)
+	}
+
+	return fmt.Errorf("unknown command %q", cmd)
+}
+
+// flags returns a FlagSet for command name that reports errors instead of exiting.
+func flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	return fs
+}
+
+// parse parses args with fs. If -h or -help is given, it writes the usage of
+// fs to stdout and returns flag.ErrHelp.
+func (c *cli) parse(fs *flag.FlagSet, args []string) error {
+	err := fs.Parse(args)
+	if err == flag.ErrHelp {
+		fs.SetOutput(c.stdout)
+		fs.Usage()
+	}
+
+	return err
+}
+
+// scanner returns a scanner of r for the current format.
+func (c *cli) scanner(r io.Reader) *bufio.Scanner {
+	sc := slices.NewLineScanner(r, c.maxLine)
+	if c.format == formatNull {
+		sc.Split(slices.ScanNull)
+	}
+	return sc
+}
+
+// read returns the values from r in the current format.
+func (c *cli) read(r io.Reader) ([]string, error) {
+	if c.format == formatJSON {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		return slices.ParseJSON(string(b))
+	}
+
+	var a []string
+
+	sc := c.scanner(r)
+	for sc.Scan() {
+		a = append(a, sc.Text())
+	}
+
+	return a, sc.Err()
+}
+
+// readFile returns the values of file name, or of stdin if name is "-".
+func (c *cli) readFile(name string) ([]string, error) {
+	if name == "-" {
+		return c.read(c.stdin)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return c.read(f)
+}
+
+// input returns the values from files, or from stdin if files is empty.
+func (c *cli) input(files []string) ([]string, error) {
+	if len(files) == 0 {
+		return c.read(c.stdin)
+	}
+
+	aa := make([][]string, 0, len(files))
+	for _, name := range files {
+		a, err := c.readFile(name)
+		if err != nil {
+			return nil, err
+		}
+		aa = append(aa, a)
+	}
+
+	return slices.Merge(aa...), nil
+}
+
+// inputReader returns a single reader over files, or stdin if files is empty.
+func (c *cli) inputReader(files []string) (io.Reader, func(), error) {
+	if len(files) == 0 {
+		return c.stdin, func() {}, nil
+	}
+
+	var (
+		rs      []io.Reader
+		closers []io.Closer
+	)
+	closeAll := func() {
+		for _, f := range closers {
+			f.Close()
+		}
+	}
+
+	for _, name := range files {
+		if name == "-" {
+			rs = append(rs, c.stdin)
+			continue
+		}
+		f, err := os.Open(name)
+		if err != nil {
+			closeAll()
+			return nil, nil, err
+		}
+		rs, closers = append(rs, f), append(closers, f)
+	}
+
+	for i := range rs {
+		rs[i] = &terminatedReader{r: rs[i], delim: c.delim()[0]}
+	}
+
+	return io.MultiReader(rs...), closeAll, nil
+}
+
+// terminatedReader reads from r and adds a final delim if r was not empty
+// and didn't end with one, so that the last value of a file doesn't run into
+// the first value of the next.
+type terminatedReader struct {
+	r         io.Reader
+	delim     byte
+	last      byte
+	seen, eof bool
+}
+
+func (t *terminatedReader) Read(p []byte) (int, error) {
+	if t.eof {
+		if t.seen && t.last != t.delim && len(p) > 0 {
+			p[0], t.last = t.delim, t.delim
+			return 1, io.EOF
+		}
+		return 0, io.EOF
+	}
+
+	n, err := t.r.Read(p)
+	if n > 0 {
+		t.seen, t.last = true, p[n-1]
+	}
+	if err == io.EOF {
+		t.eof = true
+		if n == 0 {
+			return t.Read(p)
+		}
+		err = nil
+	}
+
+	return n, err
+}
+
+// delim returns the output delimiter of the current format.
+func (c *cli) delim() string {
+	if c.format == formatNull {
+		return "\x00"
+	}
+	return "\n"
+}
+
+// write writes the values in a in the current format.
+func (c *cli) write(a []string) error {
+	if c.format == formatJSON {
+		_, err := fmt.Fprintln(c.stdout, slices.FormatJSON(a))
+		return err
+	}
+
+	_, err := io.WriteString(c.stdout, slices.FormatLines(a, c.delim()))
+	return err
+}
+
+// writeGroups writes the groups in aa in the current format.
+func (c *cli) writeGroups(aa [][]string) error {
+	if c.format == formatJSON {
+		if aa == nil {
+			aa = [][]string{}
+		}
+		b, err := json.Marshal(aa)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(c.stdout, "%s\n", b)
+		return err
+	}
+
+	bw := bufio.NewWriter(c.stdout)
+	for i, a := range aa {
+		if i > 0 {
+			bw.WriteString(c.delim())
+		}
+		bw.WriteString(slices.FormatLines(a, c.delim()))
+	}
+
+	return bw.Flush()
+}
+
+// stream runs f over the input files in line mode. In NUL and JSON modes, where
+// values may contain newlines, it reads all the input and writes the result of g.
+func (c *cli) stream(files []string, f func(io.Writer, *bufio.Scanner) error, g func([]string) []string) error {
+	if c.format != formatLines {
+		a, err := c.input(files)
+		if err != nil {
+			return err
+		}
+		return c.write(g(a))
+	}
+
+	r, done, err := c.inputReader(files)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	return f(c.stdout, c.scanner(r))
+}
+
+func (c *cli) unique(args []string) error {
+	fs := flags("unique")
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+
+	return c.stream(fs.Args(),
+		func(w io.Writer, sc *bufio.Scanner) error {
+			return slices.StreamUnique(w, sc, 0)
+		},
+		slices.Unique)
+}
+
+func (c *cli) compare(cmd string, args []string) error {
+	fs := flags(cmd)
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("usage: %s FILE [FILE...]", cmd)
+	}
+
+	b, err := c.readFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	a, err := c.input(fs.Args()[1:])
+	if err != nil {
+		return err
+	}
+
+	if cmd == "diff" {
+		return c.write(slices.Diff(a, b))
+	}
+
+	return c.write(slices.Intersect(a, b))
+}
+
+func (c *cli) filter(args []string) error {
+	var (
+		prefix, suffix, contains, expr string
+		invert                         bool
+	)
+
+	fs := flags("filter")
+	fs.StringVar(&prefix, "prefix", "", "match lines with prefix")
+	fs.StringVar(&suffix, "suffix", "", "match lines with suffix")
+	fs.StringVar(&contains, "contains", "", "match lines containing substring")
+	fs.StringVar(&expr, "regexp", "", "match lines matching regular expression")
+	fs.BoolVar(&invert, "v", false, "select non-matching lines")
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+
+	var fns []slices.ValueFunc
+
+	var err error
+
+	fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "prefix":
+			fns = append(fns, slices.ValueHasPrefix(prefix))
+		case "suffix":
+			fns = append(fns, slices.ValueHasSuffix(suffix))
+		case "contains":
+			fns = append(fns, slices.ValueContains(contains))
+		case "regexp":
+			var re *regexp.Regexp
+			if re, err = regexp.Compile(expr); err == nil {
+				fns = append(fns, re.MatchString)
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(fns) == 0 {
+		return errors.New("usage: filter [-v] [-prefix P] [-suffix S] [-contains C] [-regexp RE] [FILE...]")
+	}
+
+	f := fns[0].And(fns[1:]...)
+	if invert {
+		f = f.Not()
+	}
+
+	return c.stream(fs.Args(),
+		func(w io.Writer, sc *bufio.Scanner) error {
+			return slices.StreamFilter(w, sc, f)
+		},
+		func(a []string) []string {
+			return slices.FilterFunc(a, f)
+		})
+}
+
+func (c *cli) chunk(args []string) error {
+	fs := flags("chunk")
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+
+	n, err := strconv.Atoi(fs.Arg(0))
+	if err != nil || n < 1 {
+		return errors.New("usage: chunk N [FILE...], with N > 0")
+	}
+
+	a, err := c.input(fs.Args()[1:])
+	if err != nil {
+		return err
+	}
+
+	return c.writeGroups(slices.Chunk(a, n))
+}
+
+func (c *cli) random(cmd string, args []string) error {
+	var seed int64
+
+	fs := flags(cmd)
+	fs.Int64Var(&seed, "seed", 0, "random seed, 0 uses the current time")
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	r := rand.New(rand.NewSource(seed))
+
+	files := fs.Args()
+
+	n := -1
+	if cmd == "rand" {
+		var err error
+		if n, err = strconv.Atoi(fs.Arg(0)); err != nil || n < 0 {
+			return errors.New("usage: rand [-seed N] N [FILE...], with N >= 0")
+		}
+		files = files[1:]
+	}
+
+	a, err := c.input(files)
+	if err != nil {
+		return err
+	}
+
+	if n >= 0 {
+		return c.write(slices.RandFunc(a, n, r.Intn))
+	}
+
+	r.Shuffle(len(a), func(i, j int) {
+		a[i], a[j] = a[j], a[i]
+	})
+
+	return c.write(a)
+}
+
+func (c *cli) reverse(args []string) error {
+	fs := flags("reverse")
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+
+	a, err := c.input(fs.Args())
+	if err != nil {
+		return err
+	}
+
+	return c.write(slices.Reverse(a))
+}
+
+func (c *cli) split(args []string) error {
+	fs := flags("split")
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("usage: split SEP [FILE...]")
+	}
+
+	a, err := c.input(fs.Args()[1:])
+	if err != nil {
+		return err
+	}
+
+	return c.writeGroups(slices.Split(a, fs.Arg(0)))
+}
+
+func (c *cli) count(args []string) error {
+	var value string
+
+	fs := flags("count")
+	fs.StringVar(&value, "value", "", "count only lines equal to value")
+	if err := c.parse(fs, args); err != nil {
+		return err
+	}
+
+	f := func(string) bool { return true }
+	fs.Visit(func(fl *flag.Flag) {
+		if fl.Name == "value" {
+			f = slices.ValueEquals(value)
+		}
+	})
+
+	var (
+		n   int
+		err error
+	)
+
+	if c.format == formatJSON {
+		var a []string
+		if a, err = c.input(fs.Args()); err == nil {
+			n = slices.CountFunc(a, f)
+		}
+	} else {
+		r, done, rerr := c.inputReader(fs.Args())
+		if rerr != nil {
+			return rerr
+		}
+		defer done()
+		n, err = slices.StreamCount(c.scanner(r), f)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(c.stdout, n)
+	return err
+}
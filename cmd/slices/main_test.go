@@ -0,0 +1,105 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "slices")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	other := filepath.Join(dir, "other")
+	if err := ioutil.WriteFile(other, []byte("b\nd"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		args []string
+		in   string
+		out  string
+		err  bool
+	}{
+		{args: []string{"unique"}, in: "a\nb\na\nc\n", out: "a\nb\nc\n"},
+		{args: []string{"diff", other}, in: "a\nb\nc\nd\n", out: "a\nc\n"},
+		{args: []string{"intersect", other}, in: "a\nb\nc\nd\n", out: "b\nd\n"},
+		{args: []string{"filter", "-prefix", "go", "-suffix", ".go"}, in: "go.mod\ngood.go\nmain.go\n", out: "good.go\n"},
+		{args: []string{"filter", "-v", "-contains", "o"}, in: "go\nrust\nzig\n", out: "rust\nzig\n"},
+		{args: []string{"filter", "-regexp", "^[a-c]+$"}, in: "abc\nabd\ncab\n", out: "abc\ncab\n"},
+		{args: []string{"filter"}, in: "a\n", err: true},
+		{args: []string{"filter", "-regexp", "("}, in: "a\n", err: true},
+		{args: []string{"filter", "-regexp", ""}, in: "a\n\nb\n", out: "a\n\nb\n"},
+		{args: []string{"filter", "-v", "-regexp", ""}, in: "a\n", out: ""},
+		{args: []string{"chunk", "2"}, in: "a\nb\nc\n", out: "a\nb\n\nc\n"},
+		{args: []string{"chunk", "0"}, in: "a\n", err: true},
+		{args: []string{"reverse"}, in: "a\nb\nc\n", out: "c\nb\na\n"},
+		{args: []string{"split", "-"}, in: "a\n-\nb\nc\n", out: "a\n\nb\nc\n"},
+		{args: []string{"split"}, in: "a\n", err: true},
+		{args: []string{"count"}, in: "a\nb\na\n", out: "3\n"},
+		{args: []string{"count", "-value", "a"}, in: "a\nb\na\n", out: "2\n"},
+		{args: []string{"rand", "-seed", "1", "2"}, in: "a\na\n", out: "a\na\n"},
+		{args: []string{"rand", "x"}, in: "a\n", err: true},
+		{args: []string{"unique", other}, in: "", out: "b\nd\n"},
+		{args: []string{"count", other, other}, in: "", out: "4\n"},
+		{args: []string{"-0", "unique"}, in: "a\nb\x00c\x00a\nb\x00", out: "a\nb\x00c\x00"},
+		{args: []string{"-0", "chunk", "1"}, in: "a\x00b\x00", out: "a\x00\x00b\x00"},
+		{args: []string{"-json", "unique"}, in: `["a","b","a"]`, out: "[\"a\",\"b\"]\n"},
+		{args: []string{"-json", "split", "-"}, in: `["a","-","b"]`, out: "[[\"a\"],[\"b\"]]\n"},
+		{args: []string{"-json", "count"}, in: `["a","b"]`, out: "2\n"},
+		{args: []string{"-json", "unique"}, in: `{}`, err: true},
+		{args: []string{"-0", "-json", "unique"}, err: true},
+		{args: []string{"bogus"}, err: true},
+		{args: nil, err: true},
+	}
+	for _, tc := range tests {
+		var sb strings.Builder
+		err := run(tc.args, strings.NewReader(tc.in), &sb)
+		if (err != nil) != tc.err {
+			t.Errorf("run(%q) error = %v, want error %v", tc.args, err, tc.err)
+			continue
+		}
+		if got := sb.String(); !tc.err && got != tc.out {
+			t.Errorf("run(%q) = %q, want %q", tc.args, got, tc.out)
+		}
+	}
+}
+
+func TestHelp(t *testing.T) {
+	for _, args := range [][]string{{"-h"}, {"filter", "-help"}} {
+		var sb strings.Builder
+		if err := run(args, strings.NewReader(""), &sb); err != flag.ErrHelp {
+			t.Errorf("run(%q) error = %v, want %v", args, err, flag.ErrHelp)
+		}
+		if !strings.Contains(sb.String(), "-") {
+			t.Errorf("run(%q) = %q, want usage", args, sb.String())
+		}
+	}
+}
+
+func TestShuffleSeed(t *testing.T) {
+	in := "a\nb\nc\nd\ne\nf\n"
+
+	var out1, out2 strings.Builder
+	if err := run([]string{"shuffle", "-seed", "42"}, strings.NewReader(in), &out1); err != nil {
+		t.Fatal(err)
+	}
+	if err := run([]string{"shuffle", "-seed", "42"}, strings.NewReader(in), &out2); err != nil {
+		t.Fatal(err)
+	}
+	if out1.String() != out2.String() {
+		t.Errorf("same seed gave %q and %q", out1.String(), out2.String())
+	}
+	if len(out1.String()) != len(in) {
+		t.Errorf("shuffle changed the number of lines: %q", out1.String())
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"strings"
+	"testing"
+)
+
+// contract is the copy and alias contract of a function, see audit.go.
+type contract int
+
+const (
+	copyContract contract = iota
+	aliasContract
+	identityContract // an alias that is the argument itself
+	mutateContract
+)
+
+// contractInput returns a slice with spare capacity, to catch functions that
+// write past the end of their argument.
+func contractInput() []string {
+	a := make([]string, 6, 10)
+	copy(a, []string{"b", "a", "c", "a", "", "d"})
+
+	return a
+}
+
+func TestContract(t *testing.T) {
+	other := []string{"a", "x"}
+	tests := []struct {
+		name     string
+		contract contract
+		f        func(a []string) []string
+	}{
+		{"AddPrefix", copyContract, func(a []string) []string { return AddPrefix(a, "p") }},
+		{"Diff", copyContract, func(a []string) []string { return Diff(a, other) }},
+		{"Diff/empty", copyContract, func(a []string) []string { return Diff(a, nil) }},
+		{"DiffAssoc", copyContract, func(a []string) []string { return DiffAssoc(a, other) }},
+		{"DiffKey", copyContract, func(a []string) []string { return DiffKey(a, other) }},
+		{"Filter", copyContract, func(a []string) []string { return Filter(a, "a") }},
+		{"FilterGlob", copyContract, func(a []string) []string { return FilterGlob(a, "*") }},
+		{"Intersect", copyContract, func(a []string) []string { return Intersect(a, other) }},
+		{"IntersectKey", copyContract, func(a []string) []string { return IntersectKey(a, other) }},
+		{"Map", copyContract, func(a []string) []string { return Map(strings.ToUpper, a) }},
+		{"Merge", copyContract, func(a []string) []string { return Merge(a) }},
+		{"MultisetDiff", copyContract, func(a []string) []string { return MultisetDiff(a, other) }},
+		{"MultisetUnion", copyContract, func(a []string) []string { return MultisetUnion(a, other) }},
+		{"Pad", copyContract, func(a []string) []string { return Pad(a, 2, "") }},
+		{"Rand", copyContract, func(a []string) []string { return Rand(a, 3) }},
+		{"Replace", copyContract, func(a []string) []string { return Replace(a, "a", "x", 1) }},
+		{"Slice", copyContract, func(a []string) []string { return Slice(a, 1, 2) }},
+		{"SortByKey", copyContract, func(a []string) []string { return SortByKey(a, strings.ToUpper) }},
+		{"Ordering.Sort", copyContract, func(a []string) []string { return ByCmp(strings.Compare).Sort(a) }},
+		{"Splice", copyContract, func(a []string) []string { return Splice(a, 1, 1, "x") }},
+		{"Splice/none", copyContract, func(a []string) []string { return Splice(a, 0, 0) }},
+		{"Trim", copyContract, func(a []string) []string { return Trim(a, "a") }},
+		{"UniqueFlags", copyContract, func(a []string) []string { return UniqueFlags(a, UniqueRegular) }},
+
+		{"Chunk", aliasContract, func(a []string) []string { return Chunk(a, 4)[0] }},
+		{"Split", aliasContract, func(a []string) []string { return Split(a, "c")[0] }},
+		{"Chunk/last", aliasContract, func(a []string) []string { aa := Chunk(a, 4); return aa[len(aa)-1] }},
+		{"Split/last", aliasContract, func(a []string) []string { aa := Split(a, "c"); return aa[len(aa)-1] }},
+		{"SplitAfter", aliasContract, func(a []string) []string { return SplitAfter(a, "c")[0] }},
+		{"FieldsAny", aliasContract, func(a []string) []string { return FieldsAny(a, "")[0] }},
+		{"SliceView", aliasContract, func(a []string) []string { return SliceView(a, 1, 2) }},
+
+		{"InsertAt/none", identityContract, func(a []string) []string { return InsertAt(a, 0) }},
+		{"Map/nil", identityContract, func(a []string) []string { return Map(nil, a) }},
+		{"Replace/none", identityContract, func(a []string) []string { return Replace(a, "x", "y", -1) }},
+		{"TrimFunc/nil", identityContract, func(a []string) []string { return TrimFunc(a, nil) }},
+
+		{"InsertAt", mutateContract, func(a []string) []string { return InsertAt(a, 2, "x") }},
+		{"Reverse", mutateContract, Reverse},
+		{"Unique", mutateContract, Unique},
+		{"InsertAtInPlace", mutateContract, func(a []string) []string { return InsertAtInPlace(a, 0, "x") }},
+		{"MapInPlace", mutateContract, func(a []string) []string { return MapInPlace(strings.ToUpper, a) }},
+		{"ReverseInPlace", mutateContract, ReverseInPlace},
+		{"SpliceInPlace", mutateContract, func(a []string) []string { return SpliceInPlace(a, 0, 1) }},
+		{"UniqueInPlace", mutateContract, UniqueInPlace},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := contractInput()
+			out := tt.f(a)
+			shared := overlaps(out, a)
+			if tt.contract == aliasContract {
+				_ = append(out, "x")
+			}
+			changed := !Equal(a[:cap(a)], contractInput()[:cap(a)])
+
+			switch tt.contract {
+			case copyContract:
+				if shared {
+					t.Error("result shares memory with the argument")
+				}
+				if changed {
+					t.Errorf("argument was modified: %q", a[:cap(a)])
+				}
+			case aliasContract:
+				if !shared {
+					t.Error("result doesn't share memory with the argument")
+				}
+				if changed {
+					t.Errorf("argument was modified: %q", a[:cap(a)])
+				}
+			case identityContract:
+				if len(out) != len(a) || &out[0] != &a[0] {
+					t.Error("result is not the argument")
+				}
+				if changed {
+					t.Errorf("argument was modified: %q", a[:cap(a)])
+				}
+			case mutateContract:
+				if !changed {
+					t.Error("argument was not modified")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrMultipleRecords is returned by ParseCSV when the input has more than one record.
+	ErrMultipleRecords = errors.New("slices: multiple CSV records")
+
+	// ErrUnterminatedQuote is returned by ParseShell and ParseList when a quote is not closed.
+	ErrUnterminatedQuote = errors.New("slices: unterminated quote")
+
+	// ErrTrailingEscape is returned by ParseShell and ParseList when the input ends with a
+	// backslash that escapes nothing.
+	ErrTrailingEscape = errors.New("slices: trailing escape")
+
+	// ErrSyntax is returned by ParseList when a quoted value is followed by other text.
+	ErrSyntax = errors.New("slices: invalid syntax")
+)
+
+// ParseCSV returns the fields of a single CSV record s, as read by encoding/csv.
+// An empty s returns nil. If s has more than one record, ErrMultipleRecords is returned.
+func ParseCSV(s string) ([]string, error) {
+	r := csv.NewReader(strings.NewReader(s))
+	r.FieldsPerRecord = -1
+
+	records, err := r.ReadAll()
+	switch {
+	case err != nil:
+		return nil, err
+	case len(records) == 0:
+		return nil, nil
+	case len(records) > 1:
+		return nil, ErrMultipleRecords
+	}
+
+	return records[0], nil
+}
+
+// FormatCSV returns the elements of a as a single CSV record, without a line ending.
+// Fields are quoted as needed by encoding/csv. A single empty field is quoted, so
+// that it isn't read back as an empty record.
+func FormatCSV(a []string) string {
+	if len(a) == 1 && a[0] == "" {
+		return `""`
+	}
+
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	_ = w.Write(a) // writes to bytes.Buffer don't fail
+	w.Flush()
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// ParseJSON returns the elements of s, a JSON array of strings.
+// The JSON literal null returns nil.
+func ParseJSON(s string) ([]string, error) {
+	var a []string
+
+	if err := json.Unmarshal([]byte(s), &a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// FormatJSON returns the elements of a as a JSON array of strings.
+// A nil slice is formatted as an empty array "[]".
+func FormatJSON(a []string) string {
+	if a == nil {
+		a = []string{}
+	}
+	b, _ := json.Marshal(a) // strings always marshal
+
+	return string(b)
+}
+
+// ParseJSONLines returns the values of s, in JSON Lines format where each
+// non-blank line is a JSON string.
+func ParseJSONLines(s string) ([]string, error) {
+	var a []string
+
+	for _, line := range ParseLines(s) {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var v string
+		if err := json.Unmarshal([]byte(line), &v); err != nil {
+			return nil, err
+		}
+		a = append(a, v)
+	}
+
+	return a, nil
+}
+
+// FormatJSONLines returns the elements of a in JSON Lines format, each as a JSON string
+// terminated by "\n".
+func FormatJSONLines(a []string) string {
+	var sb strings.Builder
+
+	for i := range a {
+		b, _ := json.Marshal(a[i])
+		sb.Write(b)
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
+
+// ParseLines splits s into lines. Lines may end in "\n" or "\r\n"; the line
+// endings are removed. A final line ending does not produce an empty last
+// element. An empty s returns nil.
+func ParseLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	a := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	for i := range a {
+		a[i] = strings.TrimSuffix(a[i], "\r")
+	}
+
+	return a
+}
+
+// FormatLines returns the elements of a as lines, each terminated by eol.
+// Typical values of eol are "\n" and "\r\n".
+func FormatLines(a []string, eol string) string {
+	if len(a) == 0 {
+		return ""
+	}
+
+	return strings.Join(a, eol) + eol
+}
+
+// ParseShell splits s into words using POSIX shell quoting rules, without any
+// expansion or substitution:
+//
+//	Words are separated by unquoted blanks and newlines.
+//	Single quotes preserve every character up to the closing quote.
+//	Double quotes preserve every character except '\', which escapes only $ ` " \ and newline.
+//	An unquoted '\' escapes the next character; a backslash-newline is removed.
+//
+// Quotes that are not closed return ErrUnterminatedQuote.
+func ParseShell(s string) ([]string, error) {
+	var (
+		a      []string
+		sb     strings.Builder
+		inWord bool
+	)
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case ' ', '\t', '\n':
+			if inWord {
+				a, inWord = append(a, sb.String()), false
+				sb.Reset()
+			}
+			continue
+
+		case '\\':
+			i++
+			if i == len(s) {
+				return nil, ErrTrailingEscape
+			}
+			if s[i] == '\n' {
+				continue
+			}
+			sb.WriteByte(s[i])
+
+		case '\'':
+			j := strings.IndexByte(s[i+1:], '\'')
+			if j == -1 {
+				return nil, ErrUnterminatedQuote
+			}
+			sb.WriteString(s[i+1 : i+1+j])
+			i += j + 1
+
+		case '"':
+			for i++; ; i++ {
+				if i == len(s) {
+					return nil, ErrUnterminatedQuote
+				}
+				if s[i] == '"' {
+					break
+				}
+				if s[i] == '\\' && i+1 < len(s) && strings.IndexByte("$`\"\\\n", s[i+1]) != -1 {
+					i++
+					if s[i] == '\n' {
+						continue
+					}
+				}
+				sb.WriteByte(s[i])
+			}
+
+		default:
+			sb.WriteByte(c)
+		}
+		inWord = true
+	}
+
+	if inWord {
+		a = append(a, sb.String())
+	}
+
+	return a, nil
+}
+
+// FormatShell returns the elements of a as a shell command line, quoting each
+// element with ShellQuote and separating them with a space.
+// ParseShell(FormatShell(a)) returns a.
+func FormatShell(a []string) string {
+	return strings.Join(Map(ShellQuote, a), " ")
+}
+
+// ShellQuote returns s quoted for a POSIX shell, so that it is read back as a single word.
+// Strings made only of safe characters are returned unchanged; other strings are
+// wrapped in single quotes, and each embedded single quote is written by
+// closing the quotes, escaping it with a backslash and reopening them.
+func ShellQuote(s string) string {
+	if s == "" {
+		return "''"
+	}
+
+	safe := true
+	for i := 0; i < len(s) && safe; i++ {
+		c := s[i]
+		safe = 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+			strings.IndexByte("@%+=:,./-_", c) != -1
+	}
+	if safe {
+		return s
+	}
+
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+// ParseList splits s, a comma separated list, into its values.
+// Unquoted values have leading and trailing white space removed.
+// Values may be double-quoted to keep commas, quotes or white space; inside quotes
+// a backslash escapes the next character. An empty s returns nil.
+//
+// Quotes that are not closed return ErrUnterminatedQuote, and text after a
+// closing quote other than white space returns ErrSyntax.
+func ParseList(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var (
+		a  []string
+		sb strings.Builder
+	)
+
+	for i := 0; ; i++ {
+		for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+			i++
+		}
+
+		if i < len(s) && s[i] == '"' {
+			for i++; ; i++ {
+				if i == len(s) {
+					return nil, ErrUnterminatedQuote
+				}
+				if s[i] == '"' {
+					break
+				}
+				if s[i] == '\\' {
+					i++
+					if i == len(s) {
+						return nil, ErrTrailingEscape
+					}
+				}
+				sb.WriteByte(s[i])
+			}
+			i++
+			for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+				i++
+			}
+			if i < len(s) && s[i] != ',' {
+				return nil, ErrSyntax
+			}
+		} else {
+			j := strings.IndexByte(s[i:], ',')
+			if j == -1 {
+				j = len(s) - i
+			}
+			sb.WriteString(strings.TrimSpace(s[i : i+j]))
+			i += j
+		}
+
+		a = append(a, sb.String())
+		sb.Reset()
+
+		if i >= len(s) {
+			return a, nil
+		}
+	}
+}
+
+// FormatList returns the elements of a as a comma separated list.
+// Values that are empty, or contain commas, quotes, backslashes or leading and
+// trailing white space are double-quoted, with '"' and '\' escaped by a backslash.
+// ParseList(FormatList(a)) returns a.
+func FormatList(a []string) string {
+	return strings.Join(Map(func(v string) string {
+		if v != "" && v == strings.TrimSpace(v) && !strings.ContainsAny(v, `,"\`) {
+			return v
+		}
+		v = strings.Replace(v, `\`, `\\`, -1)
+		return `"` + strings.Replace(v, `"`, `\"`, -1) + `"`
+	}, a), ",")
+}
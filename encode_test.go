@@ -0,0 +1,197 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"testing"
+)
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		in  string
+		out []string
+		err bool
+	}{
+		{in: `a,b,c`, out: []string{"a", "b", "c"}},
+		{in: `"a,1","say ""hi""",`, out: []string{"a,1", `say "hi"`, ""}},
+		{in: "", out: nil},
+		{in: `""`, out: []string{""}},
+		{in: "a,b\nc,d", err: true},
+		{in: `"a`, err: true},
+	}
+	for _, tc := range tests {
+		out, err := ParseCSV(tc.in)
+		if (err != nil) != tc.err || !Equal(out, tc.out) {
+			t.Errorf("ParseCSV(%q) = %q, %v; want %q", tc.in, out, err, tc.out)
+		}
+		if err == nil && tc.in != "" {
+			if s := FormatCSV(out); s != tc.in {
+				t.Errorf("FormatCSV(%q) = %q, want %q", out, s, tc.in)
+			}
+		}
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	tests := []struct {
+		in  string
+		out []string
+		err bool
+	}{
+		{in: `["a","b \"c\"","é"]`, out: []string{"a", `b "c"`, "é"}},
+		{in: `[]`, out: []string{}},
+		{in: `null`, out: nil},
+		{in: `[1,2]`, err: true},
+		{in: `["a"`, err: true},
+	}
+	for _, tc := range tests {
+		out, err := ParseJSON(tc.in)
+		if (err != nil) != tc.err || !Equal(out, tc.out) {
+			t.Errorf("ParseJSON(%q) = %q, %v; want %q", tc.in, out, err, tc.out)
+		}
+		if err == nil {
+			back, _ := ParseJSON(FormatJSON(out))
+			if !Equal(back, out) {
+				t.Errorf("ParseJSON(FormatJSON(%q)) = %q", out, back)
+			}
+		}
+	}
+	if s := FormatJSON(nil); s != "[]" {
+		t.Errorf("FormatJSON(nil) = %q, want %q", s, "[]")
+	}
+}
+
+func TestParseJSONLines(t *testing.T) {
+	a := []string{"a", "line\nbreak", ""}
+	s := FormatJSONLines(a)
+	if want := "\"a\"\n\"line\\nbreak\"\n\"\"\n"; s != want {
+		t.Errorf("FormatJSONLines() = %q, want %q", s, want)
+	}
+	out, err := ParseJSONLines(s + "\r\n\n")
+	if err != nil || !Equal(out, a) {
+		t.Errorf("ParseJSONLines() = %q, %v; want %q", out, err, a)
+	}
+	if _, err := ParseJSONLines("\"a\"\nb\n"); err == nil {
+		t.Error("ParseJSONLines() expected error")
+	}
+}
+
+func TestParseLines(t *testing.T) {
+	tests := []struct {
+		in  string
+		out []string
+	}{
+		{in: "", out: nil},
+		{in: "\n", out: []string{""}},
+		{in: "a\nb", out: []string{"a", "b"}},
+		{in: "a\nb\n", out: []string{"a", "b"}},
+		{in: "a\r\nb\r\n", out: []string{"a", "b"}},
+		{in: "a\n\nb\n", out: []string{"a", "", "b"}},
+	}
+	for _, tc := range tests {
+		if out := ParseLines(tc.in); !Equal(out, tc.out) || (out == nil) != (tc.out == nil) {
+			t.Errorf("ParseLines(%q) = %q, want %q", tc.in, out, tc.out)
+		}
+	}
+
+	a := []string{"a", "b"}
+	if s := FormatLines(a, "\r\n"); s != "a\r\nb\r\n" {
+		t.Errorf("FormatLines() = %q", s)
+	}
+	if s := FormatLines(nil, "\n"); s != "" {
+		t.Errorf("FormatLines(nil) = %q", s)
+	}
+}
+
+func TestParseShell(t *testing.T) {
+	tests := []struct {
+		in  string
+		out []string
+		err error
+	}{
+		{in: "", out: nil},
+		{in: "  ls  -l\t/tmp\n", out: []string{"ls", "-l", "/tmp"}},
+		{in: `echo 'a b' "c d"`, out: []string{"echo", "a b", "c d"}},
+		{in: `a'b'"c"d`, out: []string{"abcd"}},
+		{in: `'' ""`, out: []string{"", ""}},
+		{in: `'it'\''s'`, out: []string{"it's"}},
+		{in: `"a\"b\$c\d"`, out: []string{`a"b$c\d`}},
+		{in: `'a\"b'`, out: []string{`a\"b`}},
+		{in: "a\\ b c\\\nd", out: []string{"a b", "cd"}},
+		{in: `'abc`, err: ErrUnterminatedQuote},
+		{in: `"abc`, err: ErrUnterminatedQuote},
+		{in: `abc\`, err: ErrTrailingEscape},
+	}
+	for _, tc := range tests {
+		out, err := ParseShell(tc.in)
+		if err != tc.err || !Equal(out, tc.out) {
+			t.Errorf("ParseShell(%q) = %q, %v; want %q, %v", tc.in, out, err, tc.out, tc.err)
+		}
+	}
+}
+
+func TestFormatShell(t *testing.T) {
+	tests := []struct {
+		in  []string
+		out string
+	}{
+		{in: nil, out: ""},
+		{in: []string{"ls", "-l", "/tmp/a_b.go"}, out: "ls -l /tmp/a_b.go"},
+		{in: []string{"echo", "a b", ""}, out: "echo 'a b' ''"},
+		{in: []string{"it's", "$HOME", `a\b`}, out: `'it'\''s' '$HOME' 'a\b'`},
+	}
+	for _, tc := range tests {
+		out := FormatShell(tc.in)
+		if out != tc.out {
+			t.Errorf("FormatShell(%q) = %q, want %q", tc.in, out, tc.out)
+		}
+		if back, err := ParseShell(out); err != nil || !Equal(back, tc.in) {
+			t.Errorf("ParseShell(%q) = %q, %v; want %q", out, back, err, tc.in)
+		}
+	}
+}
+
+func TestParseList(t *testing.T) {
+	tests := []struct {
+		in  string
+		out []string
+		err error
+	}{
+		{in: "", out: nil},
+		{in: "a, b ,c", out: []string{"a", "b", "c"}},
+		{in: "a,,b,", out: []string{"a", "", "b", ""}},
+		{in: `"a, b", " c ",d`, out: []string{"a, b", " c ", "d"}},
+		{in: `"say \"hi\"","back\\slash"`, out: []string{`say "hi"`, `back\slash`}},
+		{in: `"a`, err: ErrUnterminatedQuote},
+		{in: `"a\`, err: ErrTrailingEscape},
+		{in: `"a"b,c`, err: ErrSyntax},
+	}
+	for _, tc := range tests {
+		out, err := ParseList(tc.in)
+		if err != tc.err || !Equal(out, tc.out) {
+			t.Errorf("ParseList(%q) = %q, %v; want %q, %v", tc.in, out, err, tc.out, tc.err)
+		}
+	}
+}
+
+func TestFormatList(t *testing.T) {
+	tests := []struct {
+		in  []string
+		out string
+	}{
+		{in: nil, out: ""},
+		{in: []string{"a", "b"}, out: "a,b"},
+		{in: []string{"a,b", " c", ""}, out: `"a,b"," c",""`},
+		{in: []string{`say "hi"`, `a\b`}, out: `"say \"hi\"","a\\b"`},
+	}
+	for _, tc := range tests {
+		out := FormatList(tc.in)
+		if out != tc.out {
+			t.Errorf("FormatList(%q) = %q, want %q", tc.in, out, tc.out)
+		}
+		if back, err := ParseList(out); err != nil || !Equal(back, tc.in) {
+			t.Errorf("ParseList(%q) = %q, %v; want %q", out, back, err, tc.in)
+		}
+	}
+}
@@ -0,0 +1,464 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// ErrFilterData is returned when unmarshaling invalid Bloom or cuckoo filter data.
+var ErrFilterData = errors.New("slices: invalid filter data")
+
+// ErrFilterFull is returned when a CuckooFilter has no room for all the values.
+var ErrFilterFull = errors.New("slices: filter is full")
+
+// hashString returns a 64-bit FNV-1a hash of s, finalised with the splitmix64 mixer.
+// The hash is stable across processes, so filters can be serialised.
+func hashString(s string) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= 1099511628211
+	}
+
+	return mix64(h)
+}
+
+// mix64 is the splitmix64 finaliser.
+func mix64(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+
+	return h
+}
+
+// Confirm returns a ValueFunc that checks values with the approximate filter
+// first, and confirms hits against the exact set of values in a.
+// Misses, which are the common case, never reach the set.
+//
+//	bf := slices.BuildBloomFilter(blocklist, 0.01)
+//	blocked := slices.Confirm(bf.Contains, blocklist)
+func Confirm(filter ValueFunc, a []string) ValueFunc {
+	return filter.And(ValueIn(a))
+}
+
+// BloomFilter is a probabilistic set of strings. Contains may return false
+// positives, at the rate given when the filter was created, but never false
+// negatives. Values can't be removed.
+//
+// The method value bf.Contains can be used as a ValueFunc.
+type BloomFilter struct {
+	bits []uint64
+	m    uint64 // number of bits
+	k    uint32 // number of hashes
+	n    uint64 // number of values added
+}
+
+// NewBloomFilter returns an empty BloomFilter sized for n values with a false
+// positive rate of fpRate. It panics if fpRate is not between 0 and 1.
+func NewBloomFilter(n int, fpRate float64) *BloomFilter {
+	if fpRate <= 0 || fpRate >= 1 {
+		panic("slices: BloomFilter fpRate out of range")
+	}
+	if n < 1 {
+		n = 1
+	}
+
+	m := math.Ceil(-float64(n) * math.Log(fpRate) / (math.Ln2 * math.Ln2))
+	k := math.Min(bloomMaxK, math.Max(1, math.Round(m/float64(n)*math.Ln2)))
+
+	return newBloomFilter(uint64(m), uint32(k))
+}
+
+// bloomMaxK is the maximum number of hashes of a BloomFilter. It is reached with
+// fpRate below 2^-64, past what 64-bit hashes can tell apart anyway.
+const bloomMaxK = 64
+
+func newBloomFilter(m uint64, k uint32) *BloomFilter {
+	m = (m + 63) &^ 63
+
+	return &BloomFilter{bits: make([]uint64, m/64), m: m, k: k}
+}
+
+// BuildBloomFilter returns a BloomFilter with the elements of a, with a false
+// positive rate of fpRate.
+func BuildBloomFilter(a []string, fpRate float64) *BloomFilter {
+	bf := NewBloomFilter(len(a), fpRate)
+	bf.AddAll(a)
+
+	return bf
+}
+
+// Add adds s to the filter.
+func (bf *BloomFilter) Add(s string) {
+	h1, h2 := bloomHashes(s)
+	for i := uint64(0); i < uint64(bf.k); i++ {
+		b := (h1 + i*h2) % bf.m
+		bf.bits[b/64] |= 1 << (b % 64)
+	}
+	bf.n++
+}
+
+// AddAll adds all the elements of a to the filter.
+func (bf *BloomFilter) AddAll(a []string) {
+	for i := range a {
+		bf.Add(a[i])
+	}
+}
+
+// Contains returns true if s may be in the filter, false if it definitely isn't.
+func (bf *BloomFilter) Contains(s string) bool {
+	h1, h2 := bloomHashes(s)
+	for i := uint64(0); i < uint64(bf.k); i++ {
+		b := (h1 + i*h2) % bf.m
+		if bf.bits[b/64]&(1<<(b%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Len returns the number of values added to the filter, including duplicates.
+func (bf *BloomFilter) Len() int {
+	return int(bf.n)
+}
+
+// bloomHashes returns the two hashes used for double hashing of s.
+func bloomHashes(s string) (uint64, uint64) {
+	h := hashString(s)
+
+	return h, mix64(h) | 1
+}
+
+const bloomMagic = "SBF1"
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (bf *BloomFilter) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, len(bloomMagic)+20+8*len(bf.bits))
+	b = append(b, bloomMagic...)
+	b = appendUint64(b, bf.m)
+	b = appendUint32(b, bf.k)
+	b = appendUint64(b, bf.n)
+	for _, w := range bf.bits {
+		b = appendUint64(b, w)
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (bf *BloomFilter) UnmarshalBinary(data []byte) error {
+	const header = len(bloomMagic) + 20
+
+	if len(data) < header || string(data[:len(bloomMagic)]) != bloomMagic {
+		return ErrFilterData
+	}
+	data = data[len(bloomMagic):]
+
+	m := binary.BigEndian.Uint64(data)
+	k := binary.BigEndian.Uint32(data[8:])
+	n := binary.BigEndian.Uint64(data[12:])
+	data = data[20:]
+
+	if m == 0 || m%64 != 0 || k == 0 || k > bloomMaxK || uint64(len(data)) != m/8 {
+		return ErrFilterData
+	}
+
+	*bf = *newBloomFilter(m, k)
+	bf.n = n
+	for i := range bf.bits {
+		bf.bits[i] = binary.BigEndian.Uint64(data[8*i:])
+	}
+
+	return nil
+}
+
+// CuckooFilter is a probabilistic set of strings that, unlike BloomFilter,
+// supports removing values. Contains may return false positives, at about the
+// rate given when the filter was created, but never false negatives.
+//
+// The method value cf.Contains can be used as a ValueFunc.
+type CuckooFilter struct {
+	buckets []uint16 // cuckooSlots fingerprints per bucket, 0 is empty
+	mask    uint64   // number of buckets - 1
+	fpMask  uint16   // fingerprint bits
+	count   uint64
+	victim  uint16 // fingerprint that could not be placed, if any
+	vindex  uint64
+	kicks   uint64
+}
+
+const (
+	cuckooSlots    = 4
+	cuckooMaxKicks = 500
+)
+
+// NewCuckooFilter returns an empty CuckooFilter sized for n values with a
+// false positive rate of about fpRate. It panics if fpRate is not between 0 and 1.
+func NewCuckooFilter(n int, fpRate float64) *CuckooFilter {
+	if fpRate <= 0 || fpRate >= 1 {
+		panic("slices: CuckooFilter fpRate out of range")
+	}
+	if n < 1 {
+		n = 1
+	}
+
+	// With b slots per bucket, f bits per fingerprint give a rate of about 2b/2^f.
+	f := math.Ceil(math.Log2(2 * cuckooSlots / fpRate))
+	f = math.Max(4, math.Min(16, f))
+
+	// Size for 95% load, rounded up to a power of two buckets.
+	nb := uint64(math.Ceil(float64(n) / cuckooSlots / 0.95))
+	nb = 1 << bits.Len64(nb-1)
+
+	return newCuckooFilter(nb, uint16(1<<uint(f)-1))
+}
+
+func newCuckooFilter(nb uint64, fpMask uint16) *CuckooFilter {
+	return &CuckooFilter{
+		buckets: make([]uint16, nb*cuckooSlots),
+		mask:    nb - 1,
+		fpMask:  fpMask,
+	}
+}
+
+// BuildCuckooFilter returns a CuckooFilter with the distinct elements of a, with
+// a false positive rate of about fpRate. Duplicates are added once, since adding
+// the same value many times fills the filter.
+//
+// If the filter becomes full, it returns the filter and ErrFilterFull; the values
+// that didn't fit are then false negatives, so the filter shouldn't be used.
+func BuildCuckooFilter(a []string, fpRate float64) (*CuckooFilter, error) {
+	seen := make(map[string]struct{}, len(a))
+	u := FilterFunc(a, func(v string) bool {
+		if _, ok := seen[v]; ok {
+			return false
+		}
+		seen[v] = struct{}{}
+		return true
+	})
+
+	cf := NewCuckooFilter(len(u), fpRate)
+	if cf.AddAll(u) < len(u) {
+		return cf, ErrFilterFull
+	}
+
+	return cf, nil
+}
+
+// fingerprint returns the non-zero fingerprint and first bucket index of s.
+func (cf *CuckooFilter) fingerprint(s string) (uint16, uint64) {
+	h := hashString(s)
+
+	fp := uint16(h>>48) & cf.fpMask
+	if fp == 0 {
+		fp = 1
+	}
+
+	return fp, h & cf.mask
+}
+
+// altIndex returns the other bucket index for fingerprint fp in bucket i.
+func (cf *CuckooFilter) altIndex(i uint64, fp uint16) uint64 {
+	return (i ^ mix64(uint64(fp))) & cf.mask
+}
+
+// insert puts fp in bucket i if it has a free slot.
+func (cf *CuckooFilter) insert(i uint64, fp uint16) bool {
+	b := cf.buckets[i*cuckooSlots : (i+1)*cuckooSlots]
+	for j := range b {
+		if b[j] == 0 {
+			b[j] = fp
+			return true
+		}
+	}
+
+	return false
+}
+
+// has returns true if bucket i holds fp.
+func (cf *CuckooFilter) has(i uint64, fp uint16) bool {
+	b := cf.buckets[i*cuckooSlots : (i+1)*cuckooSlots]
+	for j := range b {
+		if b[j] == fp {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Add adds s to the filter. It returns false if the filter is too full to add s.
+// Adding the same value more than 2*4 times also fills the filter.
+func (cf *CuckooFilter) Add(s string) bool {
+	if cf.victim != 0 {
+		return false
+	}
+
+	fp, i1 := cf.fingerprint(s)
+	i2 := cf.altIndex(i1, fp)
+	if cf.insert(i1, fp) || cf.insert(i2, fp) {
+		cf.count++
+		return true
+	}
+
+	// Evict fingerprints until one finds a free slot.
+	i := i1
+	if cf.kicks%2 == 1 {
+		i = i2
+	}
+	for n := 0; n < cuckooMaxKicks; n++ {
+		cf.kicks++
+		j := i*cuckooSlots + cf.kicks%cuckooSlots
+		fp, cf.buckets[j] = cf.buckets[j], fp
+
+		i = cf.altIndex(i, fp)
+		if cf.insert(i, fp) {
+			cf.count++
+			return true
+		}
+	}
+
+	// Keep the homeless fingerprint so there are no false negatives.
+	cf.victim, cf.vindex = fp, i
+	cf.count++
+
+	return true
+}
+
+// AddAll adds all the elements of a to the filter, and returns the number of
+// elements that were added before the filter became full.
+func (cf *CuckooFilter) AddAll(a []string) int {
+	for i := range a {
+		if !cf.Add(a[i]) {
+			return i
+		}
+	}
+
+	return len(a)
+}
+
+// Contains returns true if s may be in the filter, false if it definitely isn't.
+func (cf *CuckooFilter) Contains(s string) bool {
+	fp, i1 := cf.fingerprint(s)
+	i2 := cf.altIndex(i1, fp)
+
+	if cf.victim == fp && (cf.vindex == i1 || cf.vindex == i2) {
+		return true
+	}
+
+	return cf.has(i1, fp) || cf.has(i2, fp)
+}
+
+// Remove removes one instance of s from the filter, and returns true if it was found.
+// Only values that were added should be removed, otherwise a false positive
+// match may remove a different value.
+func (cf *CuckooFilter) Remove(s string) bool {
+	fp, i1 := cf.fingerprint(s)
+	i2 := cf.altIndex(i1, fp)
+
+	if cf.victim == fp && (cf.vindex == i1 || cf.vindex == i2) {
+		cf.victim, cf.vindex = 0, 0
+		cf.count--
+		return true
+	}
+
+	for _, i := range [2]uint64{i1, i2} {
+		b := cf.buckets[i*cuckooSlots : (i+1)*cuckooSlots]
+		for j := range b {
+			if b[j] == fp {
+				b[j] = 0
+				cf.count--
+				cf.reinsertVictim()
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// reinsertVictim tries to place the victim fingerprint after a slot was freed.
+func (cf *CuckooFilter) reinsertVictim() {
+	if cf.victim == 0 {
+		return
+	}
+
+	fp, i := cf.victim, cf.vindex
+	if cf.insert(i, fp) || cf.insert(cf.altIndex(i, fp), fp) {
+		cf.victim, cf.vindex = 0, 0
+	}
+}
+
+// Len returns the number of values in the filter.
+func (cf *CuckooFilter) Len() int {
+	return int(cf.count)
+}
+
+const cuckooMagic = "SCF1"
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (cf *CuckooFilter) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, len(cuckooMagic)+28+2*len(cf.buckets))
+	b = append(b, cuckooMagic...)
+	b = appendUint64(b, cf.mask+1)
+	b = appendUint16(b, cf.fpMask)
+	b = appendUint64(b, cf.count)
+	b = appendUint16(b, cf.victim)
+	b = appendUint64(b, cf.vindex)
+	for _, fp := range cf.buckets {
+		b = appendUint16(b, fp)
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (cf *CuckooFilter) UnmarshalBinary(data []byte) error {
+	const header = len(cuckooMagic) + 28
+
+	if len(data) < header || string(data[:len(cuckooMagic)]) != cuckooMagic {
+		return ErrFilterData
+	}
+	data = data[len(cuckooMagic):]
+
+	nb := binary.BigEndian.Uint64(data)
+	fpMask := binary.BigEndian.Uint16(data[8:])
+	count := binary.BigEndian.Uint64(data[10:])
+	victim := binary.BigEndian.Uint16(data[18:])
+	vindex := binary.BigEndian.Uint64(data[20:])
+	data = data[28:]
+
+	if nb == 0 || nb&(nb-1) != 0 || fpMask == 0 || vindex >= nb ||
+		nb > uint64(len(data))/(cuckooSlots*2) || uint64(len(data)) != nb*cuckooSlots*2 {
+		return ErrFilterData
+	}
+
+	*cf = *newCuckooFilter(nb, fpMask)
+	cf.count, cf.victim, cf.vindex = count, victim, vindex
+	for i := range cf.buckets {
+		cf.buckets[i] = binary.BigEndian.Uint16(data[2*i:])
+	}
+
+	return nil
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	return appendUint32(appendUint32(b, uint32(v>>32)), uint32(v))
+}
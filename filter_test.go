@@ -0,0 +1,169 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"fmt"
+	"testing"
+)
+
+// filterData returns n members and n non-members for filter tests.
+func filterData(n int) (in, out []string) {
+	in, out = make([]string, n), make([]string, n)
+	for i := 0; i < n; i++ {
+		in[i] = fmt.Sprintf("host-%d.example.com", i)
+		out[i] = fmt.Sprintf("other-%d.example.org", i)
+	}
+
+	return in, out
+}
+
+// falsePositives returns the rate of values in out where f is true.
+func falsePositives(f ValueFunc, out []string) float64 {
+	return float64(CountFunc(out, f)) / float64(len(out))
+}
+
+func TestBloomFilter(t *testing.T) {
+	in, out := filterData(10000)
+
+	for _, rate := range []float64{0.1, 0.01, 0.001} {
+		bf := BuildBloomFilter(in, rate)
+		if !All(in, bf.Contains) {
+			t.Fatalf("rate %v: false negative", rate)
+		}
+		if fp := falsePositives(bf.Contains, out); fp > 2*rate {
+			t.Errorf("rate %v: false positive rate %v", rate, fp)
+		}
+		if bf.Len() != len(in) {
+			t.Errorf("Len() = %v, want %v", bf.Len(), len(in))
+		}
+
+		data, err := bf.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var bf2 BloomFilter
+		if err := bf2.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if !All(in, bf2.Contains) || CountFunc(out, bf2.Contains) != CountFunc(out, bf.Contains) {
+			t.Errorf("rate %v: unmarshaled filter differs", rate)
+		}
+	}
+
+	// m = 64 with k = 2^32-1 hashes.
+	manyHashes := appendUint64(appendUint32(appendUint64([]byte("SBF1"), 64), 1<<32-1), 0)
+	manyHashes = append(manyHashes, make([]byte, 8)...)
+
+	var bf BloomFilter
+	for _, data := range [][]byte{nil, []byte("SBF1"), []byte("XXXX" + string(make([]byte, 28))), manyHashes} {
+		if err := bf.UnmarshalBinary(data); err != ErrFilterData {
+			t.Errorf("UnmarshalBinary(%q) error = %v, want %v", data, err, ErrFilterData)
+		}
+	}
+}
+
+func TestCuckooFilter(t *testing.T) {
+	in, out := filterData(10000)
+
+	for _, rate := range []float64{0.1, 0.01, 0.001} {
+		cf, err := BuildCuckooFilter(in, rate)
+		if err != nil {
+			t.Fatalf("rate %v: BuildCuckooFilter() error = %v", rate, err)
+		}
+		if cf.Len() != len(in) {
+			t.Fatalf("rate %v: Len() = %v, want %v", rate, cf.Len(), len(in))
+		}
+		if !All(in, cf.Contains) {
+			t.Fatalf("rate %v: false negative", rate)
+		}
+		if fp := falsePositives(cf.Contains, out); fp > 2*rate {
+			t.Errorf("rate %v: false positive rate %v", rate, fp)
+		}
+
+		data, err := cf.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cf2 CuckooFilter
+		if err := cf2.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if cf2.Len() != cf.Len() || !All(in, cf2.Contains) {
+			t.Errorf("rate %v: unmarshaled filter differs", rate)
+		}
+
+		for _, s := range in[:5000] {
+			if !cf.Remove(s) {
+				t.Fatalf("Remove(%q) = false", s)
+			}
+		}
+		if !All(in[5000:], cf.Contains) {
+			t.Errorf("rate %v: false negative after Remove", rate)
+		}
+		if cf.Len() != 5000 {
+			t.Errorf("Len() = %v after Remove, want 5000", cf.Len())
+		}
+	}
+
+	// 2^61 buckets and no payload, where the payload size overflows.
+	huge := appendUint64(appendUint16(appendUint64([]byte("SCF1"), 1<<61), 0xff), 0)
+	huge = appendUint64(appendUint16(huge, 0), 0)
+
+	for _, data := range [][]byte{[]byte("SCF1"), huge} {
+		if err := new(CuckooFilter).UnmarshalBinary(data); err != ErrFilterData {
+			t.Errorf("UnmarshalBinary(%q) error = %v, want %v", data, err, ErrFilterData)
+		}
+	}
+}
+
+func TestCuckooFilterFull(t *testing.T) {
+	in, _ := filterData(1000)
+
+	cf := NewCuckooFilter(10, 0.01)
+	n := cf.AddAll(in)
+	if n == len(in) {
+		t.Fatal("AddAll() did not report a full filter")
+	}
+	if !All(in[:n], cf.Contains) {
+		t.Error("false negative in full filter")
+	}
+	if cf.Add("one more") {
+		t.Error("Add() to a full filter = true")
+	}
+}
+
+func TestBuildCuckooFilterDuplicates(t *testing.T) {
+	cf, err := BuildCuckooFilter(append(Repeat("x", 9), "y"), 0.01)
+	if err != nil || !cf.Contains("x") || !cf.Contains("y") || cf.Len() != 2 {
+		t.Errorf("BuildCuckooFilter() = %v, %v; want x and y in the filter", cf.Len(), err)
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	in, out := filterData(1000)
+
+	bf := BuildBloomFilter(in, 0.2)
+	f := Confirm(bf.Contains, in)
+	if !All(in, f) || !None(out, f) {
+		t.Error("Confirm() is not exact")
+	}
+}
+
+func TestFilterPanics(t *testing.T) {
+	for _, f := range []func(){
+		func() { NewBloomFilter(10, 0) },
+		func() { NewBloomFilter(10, 1) },
+		func() { NewCuckooFilter(10, -1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
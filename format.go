@@ -0,0 +1,287 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Text layout for terminals. Widths are measured in terminal cells: East Asian
+// wide and fullwidth characters take two cells, combining marks and other
+// zero-width characters take none, and everything else takes one. Ambiguous
+// width characters are counted as narrow. The text is expected to have no
+// newlines or other control characters.
+
+// wideRanges are the code point ranges of East Asian wide (W) and fullwidth (F)
+// characters, from Unicode's EastAsianWidth.txt, merged and sorted.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F}, {0x231A, 0x231B}, {0x2329, 0x232A}, {0x23E9, 0x23EC},
+	{0x23F0, 0x23F0}, {0x23F3, 0x23F3}, {0x25FD, 0x25FE}, {0x2614, 0x2615},
+	{0x2648, 0x2653}, {0x267F, 0x267F}, {0x2693, 0x2693}, {0x26A1, 0x26A1},
+	{0x26AA, 0x26AB}, {0x26BD, 0x26BE}, {0x26C4, 0x26C5}, {0x26CE, 0x26CE},
+	{0x26D4, 0x26D4}, {0x26EA, 0x26EA}, {0x26F2, 0x26F3}, {0x26F5, 0x26F5},
+	{0x26FA, 0x26FA}, {0x26FD, 0x26FD}, {0x2705, 0x2705}, {0x270A, 0x270B},
+	{0x2728, 0x2728}, {0x274C, 0x274C}, {0x274E, 0x274E}, {0x2753, 0x2755},
+	{0x2757, 0x2757}, {0x2795, 0x2797}, {0x27B0, 0x27B0}, {0x27BF, 0x27BF},
+	{0x2B1B, 0x2B1C}, {0x2B50, 0x2B50}, {0x2B55, 0x2B55}, {0x2E80, 0x303E},
+	{0x3041, 0x33FF}, {0x3400, 0x4DBF}, {0x4E00, 0x9FFF}, {0xA000, 0xA4CF},
+	{0xA960, 0xA97F}, {0xAC00, 0xD7A3}, {0xF900, 0xFAFF}, {0xFE10, 0xFE19},
+	{0xFE30, 0xFE6F}, {0xFF00, 0xFF60}, {0xFFE0, 0xFFE6}, {0x16FE0, 0x16FE4},
+	{0x17000, 0x18CFF}, {0x1AFF0, 0x1B2FF}, {0x1F004, 0x1F004}, {0x1F0CF, 0x1F0CF},
+	{0x1F18E, 0x1F18E}, {0x1F191, 0x1F19A}, {0x1F200, 0x1F202}, {0x1F210, 0x1F23B},
+	{0x1F240, 0x1F248}, {0x1F250, 0x1F251}, {0x1F260, 0x1F265}, {0x1F300, 0x1F320},
+	{0x1F32D, 0x1F335}, {0x1F337, 0x1F37C}, {0x1F37E, 0x1F393}, {0x1F3A0, 0x1F3CA},
+	{0x1F3CF, 0x1F3D3}, {0x1F3E0, 0x1F3F0}, {0x1F3F4, 0x1F3F4}, {0x1F3F8, 0x1F43E},
+	{0x1F440, 0x1F440}, {0x1F442, 0x1F4FC}, {0x1F4FF, 0x1F53D}, {0x1F54B, 0x1F54E},
+	{0x1F550, 0x1F567}, {0x1F57A, 0x1F57A}, {0x1F595, 0x1F596}, {0x1F5A4, 0x1F5A4},
+	{0x1F5FB, 0x1F64F}, {0x1F680, 0x1F6C5}, {0x1F6CC, 0x1F6CC}, {0x1F6D0, 0x1F6D2},
+	{0x1F6D5, 0x1F6D7}, {0x1F6DC, 0x1F6DF}, {0x1F6EB, 0x1F6EC}, {0x1F6F4, 0x1F6FC},
+	{0x1F7E0, 0x1F7EB}, {0x1F7F0, 0x1F7F0}, {0x1F90C, 0x1F93A}, {0x1F93C, 0x1F945},
+	{0x1F947, 0x1F9FF}, {0x1FA70, 0x1FAFF}, {0x20000, 0x2FFFD}, {0x30000, 0x3FFFD},
+}
+
+// runeWidth returns the number of terminal cells taken by r.
+func runeWidth(r rune) int {
+	switch {
+	case r == 0 || r == 0x200B || unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case r < wideRanges[0][0]:
+		return 1
+	}
+
+	lo, hi := 0, len(wideRanges)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		switch {
+		case r < wideRanges[m][0]:
+			hi = m
+		case r > wideRanges[m][1]:
+			lo = m + 1
+		default:
+			return 2
+		}
+	}
+
+	return 1
+}
+
+// StringWidth returns the number of terminal cells taken by s.
+func StringWidth(s string) int {
+	var n int
+
+	for _, r := range s {
+		n += runeWidth(r)
+	}
+
+	return n
+}
+
+// TruncateWidth returns s cut to fit in width terminal cells. If s is cut, tail
+// is added at the end, within width; a wide character that doesn't fit is dropped
+// whole. If width is less than the width of tail, s is cut without tail.
+//
+//	TruncateWidth("日本語テキスト", 7, "…") // "日本語…"
+func TruncateWidth(s string, width int, tail string) string {
+	if StringWidth(s) <= width {
+		return s
+	}
+
+	tw := StringWidth(tail)
+	if tw > width {
+		tail, tw = "", 0
+	}
+
+	w := 0
+	for i, r := range s {
+		rw := runeWidth(r)
+		if w+rw > width-tw {
+			return s[:i] + tail
+		}
+		w += rw
+	}
+
+	return s
+}
+
+// padWidth returns s followed by spaces to fill width terminal cells.
+func padWidth(s string, width int) string {
+	if n := width - StringWidth(s); n > 0 {
+		return s + strings.Repeat(" ", n)
+	}
+
+	return s
+}
+
+// Columns returns the elements of a laid out in columns, like ls, in lines that
+// fit in width terminal cells. The elements are sorted down the columns, which
+// are separated by two spaces. Elements wider than width are placed on lines of
+// their own. If width <= 0, all the elements are placed on one line.
+// Each line ends in "\n". An empty a returns "".
+func Columns(a []string, width int) string {
+	if len(a) == 0 {
+		return ""
+	}
+
+	widths := make([]int, len(a))
+	for i := range a {
+		widths[i] = StringWidth(a[i])
+	}
+
+	rows, colw := columnLayout(widths, width)
+
+	var sb strings.Builder
+
+	for r := 0; r < rows; r++ {
+		for c, i := 0, r; i < len(a); c, i = c+1, i+rows {
+			if c > 0 {
+				sb.WriteString("  ")
+			}
+			if last := i+rows >= len(a); last {
+				sb.WriteString(a[i])
+			} else {
+				sb.WriteString(padWidth(a[i], colw[c]))
+			}
+		}
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
+
+// columnLayout returns the fewest rows, and the width of each column, that lay out
+// elements of the given widths down columns within width.
+func columnLayout(widths []int, width int) (int, []int) {
+	n := len(widths)
+
+	for rows := 1; rows < n; rows++ {
+		cols := (n + rows - 1) / rows
+		colw := make([]int, cols)
+		total := 2 * (cols - 1)
+		for i, w := range widths {
+			if c := i / rows; w > colw[c] {
+				total += w - colw[c]
+				colw[c] = w
+			}
+		}
+
+		if width <= 0 || total <= width {
+			return rows, colw
+		}
+	}
+
+	return n, nil
+}
+
+// tableCells returns the cells of aa with missing cells added as "", each cell
+// cut to maxWidth and then passed to escape, and the width of each column.
+// If maxWidth <= 0, cells are not cut.
+func tableCells(aa [][]string, maxWidth int, escape func(string) string) ([][]string, []int) {
+	var cols int
+	for _, row := range aa {
+		if len(row) > cols {
+			cols = len(row)
+		}
+	}
+
+	cells := make([][]string, len(aa))
+	colw := make([]int, cols)
+	for i, row := range aa {
+		cells[i] = make([]string, cols)
+		for j := range row {
+			v := row[j]
+			if maxWidth > 0 {
+				v = TruncateWidth(v, maxWidth, "…")
+			}
+			if escape != nil {
+				v = escape(v)
+			}
+			if w := StringWidth(v); w > colw[j] {
+				colw[j] = w
+			}
+			cells[i][j] = v
+		}
+	}
+
+	return cells, colw
+}
+
+// FormatTable returns the rows of aa as a text table, with the columns aligned
+// and separated by two spaces. Cells wider than maxWidth terminal cells are cut
+// and end in "…"; if maxWidth <= 0, cells are not cut. Short rows are padded.
+// Each line ends in "\n", without trailing spaces.
+//
+//	FormatTable(Chunk(a, 3), 20)
+func FormatTable(aa [][]string, maxWidth int) string {
+	cells, colw := tableCells(aa, maxWidth, nil)
+
+	var sb strings.Builder
+
+	for _, row := range cells {
+		var line strings.Builder
+		for j, v := range row {
+			if j > 0 {
+				line.WriteString("  ")
+			}
+			line.WriteString(padWidth(v, colw[j]))
+		}
+		sb.WriteString(strings.TrimRight(line.String(), " "))
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
+
+// FormatMarkdown returns the rows of aa as a Markdown table, with the first row
+// as the header. Cells are cut to maxWidth like FormatTable, and "|" in cells is
+// escaped. An empty aa returns "".
+func FormatMarkdown(aa [][]string, maxWidth int) string {
+	if len(aa) == 0 {
+		return ""
+	}
+
+	cells, colw := tableCells(aa, maxWidth, func(v string) string {
+		return strings.Replace(v, "|", `\|`, -1)
+	})
+	for j := range colw {
+		if colw[j] < 3 {
+			colw[j] = 3
+		}
+	}
+
+	var sb strings.Builder
+
+	writeRow := func(row []string) {
+		sb.WriteByte('|')
+		for j, v := range row {
+			sb.WriteString(" " + padWidth(v, colw[j]) + " |")
+		}
+		sb.WriteByte('\n')
+	}
+
+	writeRow(cells[0])
+	sep := make([]string, len(colw))
+	for j := range sep {
+		sep[j] = strings.Repeat("-", colw[j])
+	}
+	writeRow(sep)
+	for _, row := range cells[1:] {
+		writeRow(row)
+	}
+
+	return sb.String()
+}
+
+// FormatCSVTable returns the rows of aa as CSV records, each ending in "\n".
+// Cells are not cut.
+func FormatCSVTable(aa [][]string) string {
+	var sb strings.Builder
+
+	for _, row := range aa {
+		sb.WriteString(FormatCSV(row))
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
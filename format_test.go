@@ -0,0 +1,125 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"testing"
+)
+
+func TestStringWidth(t *testing.T) {
+	tests := []struct {
+		in  string
+		out int
+	}{
+		{in: "", out: 0},
+		{in: "abc", out: 3},
+		{in: "日本語", out: 6},
+		{in: "ｱｲ", out: 2}, // halfwidth katakana
+		{in: "ＡＢ", out: 4}, // fullwidth latin
+		{in: "한국", out: 4},
+		{in: "e\u0301", out: 1},  // combining accent
+		{in: "a\u200bb", out: 2}, // zero width space
+		{in: "🙂x", out: 3},
+		{in: "±", out: 1}, // ambiguous
+	}
+	for _, tc := range tests {
+		if out := StringWidth(tc.in); out != tc.out {
+			t.Errorf("StringWidth(%q) = %v, want %v", tc.in, out, tc.out)
+		}
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	tests := []struct {
+		in    string
+		width int
+		tail  string
+		out   string
+	}{
+		{in: "hello", width: 5, tail: "…", out: "hello"},
+		{in: "hello", width: 4, tail: "…", out: "hel…"},
+		{in: "hello", width: 4, tail: "", out: "hell"},
+		{in: "日本語テキスト", width: 7, tail: "…", out: "日本語…"},
+		{in: "日本語テキスト", width: 6, tail: "…", out: "日本…"},
+		{in: "日本語", width: 1, tail: "...", out: ""},
+		{in: "hello", width: 2, tail: "...", out: "he"},
+		{in: "hello", width: 0, tail: "…", out: ""},
+	}
+	for _, tc := range tests {
+		if out := TruncateWidth(tc.in, tc.width, tc.tail); out != tc.out {
+			t.Errorf("TruncateWidth(%q, %d, %q) = %q, want %q", tc.in, tc.width, tc.tail, out, tc.out)
+		}
+	}
+}
+
+func TestColumns(t *testing.T) {
+	a := []string{"one", "two", "three", "four", "five", "six", "seven"}
+
+	tests := []struct {
+		in    []string
+		width int
+		out   string
+	}{
+		{in: nil, width: 80, out: ""},
+		{in: a, width: 0, out: "one  two  three  four  five  six  seven\n"},
+		{in: a, width: 80, out: "one  two  three  four  five  six  seven\n"},
+		{in: a, width: 30, out: "" +
+			"one  three  five  seven\n" +
+			"two  four   six\n"},
+		{in: a, width: 20, out: "" +
+			"one    four  seven\n" +
+			"two    five\n" +
+			"three  six\n"},
+		{in: a, width: 3, out: "one\ntwo\nthree\nfour\nfive\nsix\nseven\n"},
+		{in: []string{"日本", "ab", "語", "c"}, width: 9, out: "" +
+			"日本  語\n" +
+			"ab    c\n"},
+	}
+	for _, tc := range tests {
+		if out := Columns(tc.in, tc.width); out != tc.out {
+			t.Errorf("Columns(%q, %d) = \n%s, want \n%s", tc.in, tc.width, out, tc.out)
+		}
+	}
+}
+
+func TestFormatTable(t *testing.T) {
+	aa := [][]string{
+		{"name", "lang", "note"},
+		{"東京", "ja"},
+		{"a|b", "en", "a long note"},
+	}
+
+	if out, want := FormatTable(aa, 0), ""+
+		"name  lang  note\n"+
+		"東京  ja\n"+
+		"a|b   en    a long note\n"; out != want {
+		t.Errorf("FormatTable() = \n%s, want \n%s", out, want)
+	}
+
+	if out, want := FormatTable(aa, 5), ""+
+		"name  lang  note\n"+
+		"東京  ja\n"+
+		"a|b   en    a lo…\n"; out != want {
+		t.Errorf("FormatTable(5) = \n%s, want \n%s", out, want)
+	}
+
+	if out, want := FormatMarkdown(aa, 6), ""+
+		"| name | lang | note   |\n"+
+		"| ---- | ---- | ------ |\n"+
+		"| 東京 | ja   |        |\n"+
+		"| a\\|b | en   | a lon… |\n"; out != want {
+		t.Errorf("FormatMarkdown() = \n%s, want \n%s", out, want)
+	}
+
+	if out, want := FormatCSVTable(aa), ""+
+		"name,lang,note\n"+
+		"東京,ja\n"+
+		"a|b,en,a long note\n"; out != want {
+		t.Errorf("FormatCSVTable() = \n%s, want \n%s", out, want)
+	}
+
+	if FormatTable(nil, 0) != "" || FormatMarkdown(nil, 0) != "" || FormatCSVTable(nil) != "" {
+		t.Error("empty table is not empty")
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package slices
+
+import (
+	"bytes"
+	"encoding"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// Fuzz targets for the laws in property_test.go, and for the decoders of the
+// filters and sketches, which take untrusted input. Run one with:
+//
+//	go test -fuzz=FuzzSlice -run=^$
+//
+// Slices are passed to the targets as a string with the elements separated by "|".
+
+// fuzzSlice returns the elements of s, separated by "|". An empty s is a nil slice.
+func fuzzSlice(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, "|")
+}
+
+func FuzzSlice(f *testing.F) {
+	f.Add("a|b|c|d", 1, 2)
+	f.Add("a|b|c|d", -2, -1)
+	f.Add("a", 5, -5)
+	f.Add("", 0, 0)
+
+	f.Fuzz(func(t *testing.T, s string, offset, length int) {
+		a := fuzzSlice(s)
+		if out, want := Slice(a, offset, length), refSlice(a, offset, length); !Equal(out, want) {
+			t.Errorf("Slice(%q, %d, %d) = %q, want %q", a, offset, length, out, want)
+		}
+		if out, want := NewVector(a).Slice(offset, length).Strings(), refSlice(a, offset, length); !Equal(out, want) {
+			t.Errorf("Vector.Slice(%q, %d, %d) = %q, want %q", a, offset, length, out, want)
+		}
+	})
+}
+
+func FuzzSplice(f *testing.F) {
+	f.Add("a|b|c|d", "x|y", 1, 2)
+	f.Add("a|b|c|d", "", -3, -1)
+	f.Add("", "x", 4, -1)
+
+	f.Fuzz(func(t *testing.T, s, r string, offset, length int) {
+		a, b := fuzzSlice(s), fuzzSlice(r)
+		if out, want := Splice(a, offset, length, b...), refSplice(a, offset, length, b); !Equal(out, want) {
+			t.Errorf("Splice(%q, %d, %d, %q) = %q, want %q", a, offset, length, b, out, want)
+		}
+		if out, want := NewVector(a).Splice(offset, length, b...).Strings(), refSplice(a, offset, length, b); !Equal(out, want) {
+			t.Errorf("Vector.Splice(%q, %d, %d, %q) = %q, want %q", a, offset, length, b, out, want)
+		}
+	})
+}
+
+func FuzzSplitN(f *testing.F) {
+	f.Add("a||b|c|", "", -1)
+	f.Add("a|x|b|x", "x", 2)
+
+	f.Fuzz(func(t *testing.T, s, sep string, n int) {
+		a := fuzzSlice(s)
+		aa := SplitN(a, sep, n)
+		switch {
+		case n == 0:
+			if aa != nil {
+				t.Errorf("SplitN(%q, %q, 0) = %q, want nil", a, sep, aa)
+			}
+		case !Equal(JoinWith(aa, joinSep(sep)...), a):
+			t.Errorf("JoinWith(SplitN(%q, %q, %d)) = %q", a, sep, n, JoinWith(aa, joinSep(sep)...))
+		case n > 0 && len(aa) > n+1:
+			t.Errorf("SplitN(%q, %q, %d) returned %d parts", a, sep, n, len(aa))
+		}
+	})
+}
+
+func FuzzParseShell(f *testing.F) {
+	f.Add(`echo 'a b' "c\"d" e\ f`)
+	f.Add(`"unterminated`)
+	f.Add("a\\\nb")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		a, err := ParseShell(s)
+		if err != nil {
+			return
+		}
+		if out, err := ParseShell(FormatShell(a)); err != nil || !Equal(out, a) {
+			t.Errorf("ParseShell(FormatShell(%q)) = %q, %v", a, out, err)
+		}
+	})
+}
+
+func FuzzParseList(f *testing.F) {
+	f.Add(`a, "b,c" , "d\"e"`)
+	f.Add(`"a" b`)
+
+	f.Fuzz(func(t *testing.T, s string) {
+		a, err := ParseList(s)
+		if err != nil {
+			return
+		}
+		if out, err := ParseList(FormatList(a)); err != nil || !Equal(out, a) {
+			t.Errorf("ParseList(FormatList(%q)) = %q, %v", a, out, err)
+		}
+	})
+}
+
+func FuzzParseCSV(f *testing.F) {
+	f.Add(`a,"b,c",""`)
+	f.Add(`""`)
+
+	f.Fuzz(func(t *testing.T, s string) {
+		a, err := ParseCSV(s)
+		if err != nil || Any(a, ValueContains("\r")) {
+			return
+		}
+		if out, err := ParseCSV(FormatCSV(a)); err != nil || !Equal(out, a) {
+			t.Errorf("ParseCSV(FormatCSV(%q)) = %q, %v", a, out, err)
+		}
+	})
+}
+
+func FuzzFormatJSON(f *testing.F) {
+	f.Add("a|b\"c|\\u00e9")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if !utf8.ValidString(s) {
+			return // encoding/json replaces invalid UTF-8
+		}
+		a := fuzzSlice(s)
+		if out, err := ParseJSON(FormatJSON(a)); err != nil || !Equal(out, a) {
+			t.Errorf("ParseJSON(FormatJSON(%q)) = %q, %v", a, out, err)
+		}
+	})
+}
+
+func FuzzMatchGlob(f *testing.F) {
+	f.Add("a/**/b*", "a/x/y/bz")
+	f.Add("[", "a")
+
+	f.Fuzz(func(t *testing.T, pattern, name string) {
+		ok, err := MatchGlob(pattern, name)
+		if err != nil {
+			if _, err := NewGlobSet(pattern); err == nil {
+				t.Errorf("NewGlobSet(%q) accepted an invalid pattern", pattern)
+			}
+			return
+		}
+		if pattern == name && !strings.ContainsAny(pattern, `*?[\`) && !ok {
+			t.Errorf("MatchGlob(%q, %q) = false for a literal pattern", pattern, name)
+		}
+	})
+}
+
+// fuzzBinary adds the encoding of v, and a truncated copy of it, to the corpus of f.
+func fuzzBinary(f *testing.F, v encoding.BinaryMarshaler) {
+	data, err := v.MarshalBinary()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(data)
+	f.Add(data[:len(data)/2])
+}
+
+func FuzzUnmarshalBloomFilter(f *testing.F) {
+	fuzzBinary(f, BuildBloomFilter([]string{"a", "b", "c"}, 0.01))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var bf BloomFilter
+		if bf.UnmarshalBinary(data) != nil {
+			return
+		}
+		bf.Add("x")
+		if !bf.Contains("x") {
+			t.Error("Contains() = false after Add()")
+		}
+	})
+}
+
+func FuzzUnmarshalCuckooFilter(f *testing.F) {
+	cf, err := BuildCuckooFilter([]string{"a", "b", "c"}, 0.01)
+	if err != nil {
+		f.Fatal(err)
+	}
+	fuzzBinary(f, cf)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var cf CuckooFilter
+		if cf.UnmarshalBinary(data) != nil {
+			return
+		}
+		if out, err := cf.MarshalBinary(); err != nil || !bytes.Equal(out, data) {
+			t.Errorf("MarshalBinary() = %x, %v, want %x", out, err, data)
+		}
+		cf.Contains("x")
+		cf.Add("x")
+		cf.Remove("x")
+	})
+}
+
+func FuzzUnmarshalHyperLogLog(f *testing.F) {
+	h := NewHyperLogLog(4)
+	h.AddAll([]string{"a", "b", "c"})
+	fuzzBinary(f, h)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var h HyperLogLog
+		if h.UnmarshalBinary(data) != nil {
+			return
+		}
+		if out, err := h.MarshalBinary(); err != nil || !bytes.Equal(out, data) {
+			t.Errorf("MarshalBinary() = %x, %v, want %x", out, err, data)
+		}
+		h.Add("x")
+		h.Count()
+	})
+}
+
+func FuzzUnmarshalCountMinSketch(f *testing.F) {
+	cm := NewCountMinSketch(0.5, 0.5, 2)
+	cm.AddAll([]string{"a", "b", "a", "c"})
+	fuzzBinary(f, cm)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var cm CountMinSketch
+		if cm.UnmarshalBinary(data) != nil {
+			return
+		}
+		cm.Add("x")
+		if cm.Estimate("x") == 0 {
+			t.Error("Estimate() = 0 after Add()")
+		}
+		out, err := cm.MarshalBinary()
+		if err == nil {
+			err = new(CountMinSketch).UnmarshalBinary(out)
+		}
+		if err != nil {
+			t.Errorf("UnmarshalBinary(MarshalBinary()) error = %v", err)
+		}
+	})
+}
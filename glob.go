@@ -0,0 +1,150 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"path"
+	"strings"
+)
+
+// MatchGlob reports whether name matches the shell pattern.
+//
+// The pattern syntax is that of path.Match, applied to each '/' separated
+// segment, with one addition: a segment that is exactly "**" matches zero or
+// more whole segments. So "**/*.go" matches "main.go" and "a/b/main.go", and
+// "internal/**/testdata/*" matches "internal/testdata/x" and "internal/a/b/testdata/x".
+// A "**" that is not a whole segment, as in "a**", behaves like "*".
+//
+// Escaping rules: a backslash escapes the next character, so `\*`, `\?` and
+// `\[` match a literal '*', '?' and '['. The separator '/' cannot be escaped.
+//
+// The only possible error is path.ErrBadPattern, when pattern is malformed.
+func MatchGlob(pattern, name string) (bool, error) {
+	ps := strings.Split(pattern, "/")
+	if err := validGlob(ps); err != nil {
+		return false, err
+	}
+
+	return matchGlob(ps, strings.Split(name, "/")), nil
+}
+
+// validGlob returns path.ErrBadPattern if any segment in ps is malformed.
+func validGlob(ps []string) error {
+	for _, p := range ps {
+		if p == "**" {
+			continue
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// matchGlob matches pattern segments ps against name segments ns.
+// The segments in ps must have been validated with validGlob.
+func matchGlob(ps, ns []string) bool {
+	for len(ps) > 0 {
+		if ps[0] == "**" {
+			for len(ps) > 0 && ps[0] == "**" {
+				ps = ps[1:]
+			}
+			if len(ps) == 0 {
+				return true
+			}
+			for i := range ns {
+				if matchGlob(ps, ns[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(ns) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(ps[0], ns[0]); !ok {
+			return false
+		}
+		ps, ns = ps[1:], ns[1:]
+	}
+
+	return len(ns) == 0
+}
+
+// FilterGlob returns a slice with all the elements of a that match the glob pattern.
+// See MatchGlob for the pattern syntax. A malformed pattern matches nothing.
+func FilterGlob(a []string, pattern string) []string {
+	return FilterFunc(a, ValueMatchesGlob(pattern))
+}
+
+// IndexGlob returns the index of the first element in a that matches the glob pattern,
+// or -1 if not found. See MatchGlob for the pattern syntax.
+func IndexGlob(a []string, pattern string) int {
+	return IndexFunc(a, ValueMatchesGlob(pattern))
+}
+
+// TrimGlob returns a slice with all the elements of a that don't match the glob pattern.
+// See MatchGlob for the pattern syntax. A malformed pattern matches nothing.
+func TrimGlob(a []string, pattern string) []string {
+	return TrimFunc(a, ValueMatchesGlob(pattern))
+}
+
+// GlobSet is a compiled list of include and exclude glob patterns.
+// The zero value is an empty set that matches nothing.
+type GlobSet struct {
+	rules []globRule
+}
+
+type globRule struct {
+	segments []string
+	negate   bool
+}
+
+// NewGlobSet compiles patterns into a GlobSet, using gitignore-style rules:
+// patterns are checked in order and the last one that matches decides the
+// result. A pattern prefixed with '!' is an exclude, which unmatches values
+// matched by earlier patterns. Use `\!` to match a literal leading '!'.
+// Empty patterns are ignored. See MatchGlob for the pattern syntax.
+//
+// The only possible error is path.ErrBadPattern, when a pattern is malformed.
+func NewGlobSet(patterns ...string) (*GlobSet, error) {
+	gs := &GlobSet{rules: make([]globRule, 0, len(patterns))}
+
+	for _, p := range patterns {
+		var negate bool
+
+		switch {
+		case p == "":
+			continue
+		case p[0] == '!':
+			p, negate = p[1:], true
+		case strings.HasPrefix(p, `\!`):
+			p = p[1:]
+		}
+
+		segments := strings.Split(p, "/")
+		if err := validGlob(segments); err != nil {
+			return nil, err
+		}
+		gs.rules = append(gs.rules, globRule{segments: segments, negate: negate})
+	}
+
+	return gs, nil
+}
+
+// Match returns true if s is matched by the set.
+// The method value gs.Match can be used as a ValueFunc.
+func (gs *GlobSet) Match(s string) bool {
+	ns := strings.Split(s, "/")
+
+	for i := len(gs.rules) - 1; i >= 0; i-- {
+		if matchGlob(gs.rules[i].segments, ns) {
+			return !gs.rules[i].negate
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"path"
+	"testing"
+)
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		out           bool
+		err           error
+	}{
+		{pattern: "*.go", name: "main.go", out: true},
+		{pattern: "*.go", name: "cmd/main.go", out: false},
+		{pattern: "**/*.go", name: "main.go", out: true},
+		{pattern: "**/*.go", name: "cmd/slices/main.go", out: true},
+		{pattern: "internal/**/testdata/*", name: "internal/testdata/x", out: true},
+		{pattern: "internal/**/testdata/*", name: "internal/a/b/testdata/x", out: true},
+		{pattern: "internal/**/testdata/*", name: "internal/a/b/testdata/x/y", out: false},
+		{pattern: "internal/**", name: "internal/a/b", out: true},
+		{pattern: "a/**/**/b", name: "a/b", out: true},
+		{pattern: "a**", name: "abc", out: true},
+		{pattern: "a**", name: "a/bc", out: false},
+		{pattern: `\*.go`, name: "*.go", out: true},
+		{pattern: `\*.go`, name: "x.go", out: false},
+		{pattern: "file[0-9].txt", name: "file7.txt", out: true},
+		{pattern: "?", name: "", out: false},
+		{pattern: "", name: "", out: true},
+		{pattern: "[", name: "x", err: path.ErrBadPattern},
+		{pattern: "**/[", name: "x", err: path.ErrBadPattern},
+	}
+	for _, tc := range tests {
+		out, err := MatchGlob(tc.pattern, tc.name)
+		if err != tc.err || out != tc.out {
+			t.Errorf("MatchGlob(%q, %q) = %v, %v; want %v, %v",
+				tc.pattern, tc.name, out, err, tc.out, tc.err)
+		}
+	}
+}
+
+func TestFilterGlob(t *testing.T) {
+	a := []string{"go.mod", "slices.go", "glob.go", "README.md", "cmd/slices/main.go"}
+	tests := []struct {
+		pattern      string
+		filter, trim []string
+		index        int
+	}{
+		{pattern: "*.go",
+			filter: []string{"slices.go", "glob.go"},
+			trim:   []string{"go.mod", "README.md", "cmd/slices/main.go"},
+			index:  1},
+		{pattern: "**/*.go",
+			filter: []string{"slices.go", "glob.go", "cmd/slices/main.go"},
+			trim:   []string{"go.mod", "README.md"},
+			index:  1},
+		{pattern: "*.txt", filter: []string{}, trim: a, index: -1},
+		{pattern: "[", filter: []string{}, trim: a, index: -1},
+	}
+	for _, tc := range tests {
+		if out := FilterGlob(a, tc.pattern); !Equal(out, tc.filter) {
+			t.Errorf("FilterGlob(%q) = %q, want %q", tc.pattern, out, tc.filter)
+		}
+		if out := TrimGlob(a, tc.pattern); !Equal(out, tc.trim) {
+			t.Errorf("TrimGlob(%q) = %q, want %q", tc.pattern, out, tc.trim)
+		}
+		if out := IndexGlob(a, tc.pattern); out != tc.index {
+			t.Errorf("IndexGlob(%q) = %v, want %v", tc.pattern, out, tc.index)
+		}
+	}
+}
+
+func TestGlobSet(t *testing.T) {
+	gs, err := NewGlobSet("**/*.go", "!**/*_test.go", "internal/**/testdata/*", `\!bang`, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		in  string
+		out bool
+	}{
+		{in: "main.go", out: true},
+		{in: "cmd/slices/main.go", out: true},
+		{in: "slices_test.go", out: false},
+		{in: "cmd/slices/main_test.go", out: false},
+		{in: "internal/x/testdata/fixture_test.go", out: true},
+		{in: "README.md", out: false},
+		{in: "!bang", out: true},
+		{in: "bang", out: false},
+	}
+	for _, tc := range tests {
+		if out := gs.Match(tc.in); out != tc.out {
+			t.Errorf("Match(%q) = %v, want %v", tc.in, out, tc.out)
+		}
+	}
+
+	a := []string{"a.go", "a_test.go", "b.md"}
+	if out := FilterFunc(a, gs.Match); !Equal(out, []string{"a.go"}) {
+		t.Errorf("FilterFunc(gs.Match) = %q", out)
+	}
+
+	if _, err := NewGlobSet("*.go", "!["); err != path.ErrBadPattern {
+		t.Errorf("NewGlobSet() error = %v, want %v", err, path.ErrBadPattern)
+	}
+
+	var zero GlobSet
+	if zero.Match("a.go") {
+		t.Error("zero GlobSet should match nothing")
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"math/rand"
+)
+
+// The InPlace functions reuse the backing array of a for their result, and
+// the Into functions append their result to a caller-provided dst. Other than
+// the lookup set built by the Diff variants, neither allocates as long as dst
+// has enough capacity.
+//
+// Note that the InPlace functions change the slice a. Elements past the end of
+// the result are set to "" so the backing array doesn't keep references to
+// the removed strings.
+
+// clearTail sets the elements of a from index n to the end to "".
+func clearTail(a []string, n int) []string {
+	for i := n; i < len(a); i++ {
+		a[i] = ""
+	}
+
+	return a[:n]
+}
+
+// DiffInPlace is like Diff, but reuses the backing array of a for the result.
+func DiffInPlace(a, b []string) []string {
+	if len(b) == 0 {
+		return a
+	}
+
+	set := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		set[v] = struct{}{}
+	}
+
+	return FilterInPlace(a, func(v string) bool {
+		_, ok := set[v]
+		return !ok
+	})
+}
+
+// DiffInto appends to dst all the elements of a that are not found in b, and
+// returns the extended slice.
+func DiffInto(dst, a, b []string) []string {
+	if len(b) == 0 {
+		return append(dst, a...)
+	}
+
+	set := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		set[v] = struct{}{}
+	}
+
+	return FilterInto(dst, a, func(v string) bool {
+		_, ok := set[v]
+		return !ok
+	})
+}
+
+// FilterInPlace is like FilterFunc, but reuses the backing array of a for the result.
+// If f is nil, no elements are kept.
+func FilterInPlace(a []string, f ValueFunc) []string {
+	if f == nil {
+		return clearTail(a, 0)
+	}
+
+	n := 0
+	for i := range a {
+		if f(a[i]) {
+			a[n] = a[i]
+			n++
+		}
+	}
+
+	return clearTail(a, n)
+}
+
+// FilterInto appends to dst all the elements of a where f(s) == true, and
+// returns the extended slice. If f is nil, dst is returned unchanged.
+func FilterInto(dst, a []string, f ValueFunc) []string {
+	if f == nil {
+		return dst
+	}
+
+	for i := range a {
+		if f(a[i]) {
+			dst = append(dst, a[i])
+		}
+	}
+
+	return dst
+}
+
+// InsertAtInPlace is the same as InsertAt: it reuses the backing array of a when
+// the result fits in its capacity. The name makes the mutation explicit.
+func InsertAtInPlace(a []string, idx int, values ...string) []string {
+	return InsertAt(a, idx, values...)
+}
+
+// MapInPlace is like Map, but stores the results in a and returns it.
+// If mapping is nil, a is returned unchanged.
+func MapInPlace(mapping func(string) string, a []string) []string {
+	if mapping == nil {
+		return a
+	}
+
+	for i := range a {
+		a[i] = mapping(a[i])
+	}
+
+	return a
+}
+
+// MapInto appends to dst each element of a with the function mapping applied,
+// and returns the extended slice. If mapping is nil, the elements are appended unchanged.
+func MapInto(dst []string, mapping func(string) string, a []string) []string {
+	if mapping == nil {
+		return append(dst, a...)
+	}
+
+	for i := range a {
+		dst = append(dst, mapping(a[i]))
+	}
+
+	return dst
+}
+
+// ReplaceInPlace is like Replace, but replaces the elements in a and returns it.
+func ReplaceInPlace(a []string, old, new string, n int) []string {
+	if old == new {
+		return a
+	}
+
+	for i := range a {
+		if n == 0 {
+			break
+		}
+		if a[i] == old {
+			a[i] = new
+			n--
+		}
+	}
+
+	return a
+}
+
+// ReverseInPlace reverses the order of the elements in a and returns it.
+func ReverseInPlace(a []string) []string {
+	for i, j := 0, len(a)-1; i < j; i, j = i+1, j-1 {
+		a[i], a[j] = a[j], a[i]
+	}
+
+	return a
+}
+
+// ShuffleInPlace randomizes the order of the elements in a and returns it.
+func ShuffleInPlace(a []string) []string {
+	if m := len(a); m > 1 {
+		rand.Shuffle(m, func(i, j int) {
+			a[i], a[j] = a[j], a[i]
+		})
+	}
+
+	return a
+}
+
+// TrimInPlace is like TrimFunc, but reuses the backing array of a for the result.
+// If f is nil, a is returned unchanged.
+func TrimInPlace(a []string, f ValueFunc) []string {
+	if f == nil {
+		return a
+	}
+
+	return FilterInPlace(a, f.Not())
+}
+
+// TrimInto appends to dst all the elements of a where f(s) == false, and
+// returns the extended slice. If f is nil, all the elements are appended.
+func TrimInto(dst, a []string, f ValueFunc) []string {
+	if f == nil {
+		return append(dst, a...)
+	}
+
+	return FilterInto(dst, a, f.Not())
+}
+
+// UniqueInPlace removes duplicate values from a, keeping the first instance of
+// each, and returns the result in the backing array of a.
+func UniqueInPlace(a []string) []string {
+	seen := make(map[string]struct{}, len(a))
+
+	return FilterInPlace(a, func(v string) bool {
+		if _, ok := seen[v]; ok {
+			return false
+		}
+		seen[v] = struct{}{}
+		return true
+	})
+}
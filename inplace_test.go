@@ -0,0 +1,96 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInPlace(t *testing.T) {
+	tests := []struct {
+		name  string
+		f     func([]string) []string
+		into  func(dst, a []string) []string
+		out   []string
+		alloc bool
+	}{
+		{name: "Filter",
+			f:    func(a []string) []string { return FilterInPlace(a, ValueHasPrefix("d")) },
+			into: func(dst, a []string) []string { return FilterInto(dst, a, ValueHasPrefix("d")) },
+			out:  []string{"dolor", "donec"}},
+		{name: "Filter nil",
+			f:    func(a []string) []string { return FilterInPlace(a, nil) },
+			into: func(dst, a []string) []string { return FilterInto(dst, a, nil) },
+			out:  []string{}},
+		{name: "Trim",
+			f:    func(a []string) []string { return TrimInPlace(a, ValueLenBetween(0, 4)) },
+			into: func(dst, a []string) []string { return TrimInto(dst, a, ValueLenBetween(0, 4)) },
+			out:  []string{"Lorem", "ipsum", "dolor", "consectetur", "donec", "tempus", "Lorem"}},
+		{name: "Map",
+			f:    func(a []string) []string { return MapInPlace(strings.ToUpper, a[:3]) },
+			into: func(dst, a []string) []string { return MapInto(dst, strings.ToUpper, a[:3]) },
+			out:  []string{"LOREM", "IPSUM", "DOLOR"}},
+		{name: "Diff",
+			f:    func(a []string) []string { return DiffInPlace(a, []string{"Lorem", "", "sit"}) },
+			into: func(dst, a []string) []string { return DiffInto(dst, a, []string{"Lorem", "", "sit"}) },
+			out:  []string{"ipsum", "dolor", "amet", "consectetur", "elit", "donec", "tempus"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := append([]string(nil), slc...)
+			got := tt.f(a)
+			if !Equal(got, tt.out) {
+				t.Errorf("InPlace() = %q, want %q", got, tt.out)
+			}
+			if len(got) > 0 && &got[0] != &a[0] {
+				t.Error("InPlace() did not reuse the backing array")
+			}
+			for i := len(got); i < len(a) && tt.name != "Map"; i++ {
+				if a[i] != "" {
+					t.Errorf("InPlace() did not clear the tail at %d: %q", i, a[i])
+				}
+			}
+
+			dst := make([]string, 1, 16)
+			dst[0] = "x"
+			got = tt.into(dst, slc)
+			if !Equal(got, append([]string{"x"}, tt.out...)) {
+				t.Errorf("Into() = %q, want %q", got, tt.out)
+			}
+			if &got[0] != &dst[0] {
+				t.Error("Into() did not reuse dst")
+			}
+		})
+	}
+}
+
+func TestReplaceInPlace(t *testing.T) {
+	a := []string{"Lorem", "", "Lorem", "Lorem"}
+	if got := ReplaceInPlace(a, "Lorem", "Florem", 2); !Equal(got, []string{"Florem", "", "Florem", "Lorem"}) {
+		t.Errorf("ReplaceInPlace() = %q", got)
+	}
+	if !Equal(a, []string{"Florem", "", "Florem", "Lorem"}) {
+		t.Errorf("ReplaceInPlace() did not change a: %q", a)
+	}
+	if got := ReplaceInPlace(a, "Lorem", "Florem", -1); !Equal(got, []string{"Florem", "", "Florem", "Florem"}) {
+		t.Errorf("ReplaceInPlace() = %q", got)
+	}
+}
+
+func TestInPlaceAllocs(t *testing.T) {
+	a := append([]string(nil), slc...)
+	dst := make([]string, 0, len(slc))
+	f := ValueHasPrefix("d")
+
+	if n := testing.AllocsPerRun(100, func() { FilterInPlace(a, f) }); n != 0 {
+		t.Errorf("FilterInPlace() allocs = %v, want 0", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { FilterInto(dst[:0], slc, f) }); n != 0 {
+		t.Errorf("FilterInto() allocs = %v, want 0", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { ReplaceInPlace(a, "x", "y", -1) }); n != 0 {
+		t.Errorf("ReplaceInPlace() allocs = %v, want 0", n)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"hash/maphash"
+)
+
+// Interner canonicalises strings, so that equal strings share the same memory.
+// The zero value is ready to use. An Interner is not safe for concurrent use.
+type Interner struct {
+	m map[string]string
+}
+
+// Intern returns the canonical copy of s. The first time a value is seen, a copy
+// of s becomes the canonical copy, so that interning a substring of a large buffer
+// doesn't keep the whole buffer alive.
+func (in *Interner) Intern(s string) string {
+	if v, ok := in.m[s]; ok {
+		return v
+	}
+
+	if in.m == nil {
+		in.m = make(map[string]string)
+	}
+	s = string([]byte(s))
+	in.m[s] = s
+
+	return s
+}
+
+// InternAll returns a new slice with the elements of a replaced by their
+// canonical copies.
+func (in *Interner) InternAll(a []string) []string {
+	return Map(in.Intern, a)
+}
+
+// Len returns the number of distinct strings in the Interner.
+func (in *Interner) Len() int {
+	return len(in.m)
+}
+
+// InternAll returns a new slice with the elements of a, where equal elements
+// share the same memory.
+func InternAll(a []string) []string {
+	var in Interner
+	return in.InternAll(a)
+}
+
+// StringTable is a compact, dictionary-encoded list of strings.
+// Each distinct value is stored once in a single byte buffer, and each element
+// is stored as a 32-bit reference to its value. Lookups work on the stored bytes,
+// so no Go strings are created per element.
+//
+// The zero value is an empty table ready to use. A StringTable is not safe for
+// concurrent use.
+type StringTable struct {
+	buf   []byte              // distinct values, concatenated
+	offs  []int               // value i is buf[offs[i]:offs[i+1]]
+	ids   []uint32            // element i is value ids[i]
+	index map[uint64][]uint32 // hash of value to value ids
+	seed  maphash.Seed
+}
+
+// NewStringTable returns a StringTable with the elements of a.
+func NewStringTable(a []string) *StringTable {
+	t := &StringTable{ids: make([]uint32, 0, len(a))}
+	t.Append(a...)
+
+	return t
+}
+
+// init prepares the lookup index of t.
+func (t *StringTable) init() {
+	if t.index == nil {
+		t.index = make(map[uint64][]uint32)
+		t.seed = maphash.MakeSeed()
+		t.offs = append(t.offs[:0], 0)
+	}
+}
+
+// hash returns the hash of s in t.
+func (t *StringTable) hash(s string) uint64 {
+	var h maphash.Hash
+
+	h.SetSeed(t.seed)
+	h.WriteString(s)
+
+	return h.Sum64()
+}
+
+// value returns the bytes of value id.
+func (t *StringTable) value(id uint32) []byte {
+	return t.buf[t.offs[id]:t.offs[id+1]:t.offs[id+1]]
+}
+
+// lookup returns the value id of s, and whether it was found.
+func (t *StringTable) lookup(s string) (uint32, bool) {
+	if t.index == nil {
+		return 0, false
+	}
+
+	for _, id := range t.index[t.hash(s)] {
+		if string(t.value(id)) == s {
+			return id, true
+		}
+	}
+
+	return 0, false
+}
+
+// add returns the value id of s, adding s to the values if needed.
+func (t *StringTable) add(s string) uint32 {
+	t.init()
+
+	h := t.hash(s)
+	for _, id := range t.index[h] {
+		if string(t.value(id)) == s {
+			return id
+		}
+	}
+
+	id := uint32(len(t.offs) - 1)
+	t.buf = append(t.buf, s...)
+	t.offs = append(t.offs, len(t.buf))
+	t.index[h] = append(t.index[h], id)
+
+	return id
+}
+
+// Append adds values to the end of t.
+func (t *StringTable) Append(values ...string) {
+	for _, s := range values {
+		t.ids = append(t.ids, t.add(s))
+	}
+}
+
+// At returns element i of t. It panics if i is out of range.
+func (t *StringTable) At(i int) string {
+	return string(t.value(t.ids[i]))
+}
+
+// Bytes returns the bytes of element i of t, without copying.
+// The returned slice must not be modified. It panics if i is out of range.
+func (t *StringTable) Bytes(i int) []byte {
+	return t.value(t.ids[i])
+}
+
+// Count returns the number of occurrences of s in t.
+func (t *StringTable) Count(s string) int {
+	id, ok := t.lookup(s)
+	if !ok {
+		return 0
+	}
+
+	var n int
+
+	for _, v := range t.ids {
+		if v == id {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Distinct returns the number of distinct values in t.
+func (t *StringTable) Distinct() int {
+	if len(t.offs) == 0 {
+		return 0
+	}
+
+	return len(t.offs) - 1
+}
+
+// FilterFunc returns a new table with all the elements of t where f(b) == true.
+// Func f is called once per distinct value, and must not modify or retain b.
+func (t *StringTable) FilterFunc(f func(b []byte) bool) *StringTable {
+	keep := make([]bool, t.Distinct())
+	for id := range keep {
+		keep[id] = f(t.value(uint32(id)))
+	}
+
+	return t.subset(func(id uint32) bool { return keep[id] })
+}
+
+// Index returns the index of the first instance of s in t, or -1 if not found.
+func (t *StringTable) Index(s string) int {
+	id, ok := t.lookup(s)
+	if !ok {
+		return -1
+	}
+
+	for i, v := range t.ids {
+		if v == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Len returns the number of elements in t.
+func (t *StringTable) Len() int {
+	return len(t.ids)
+}
+
+// Size returns the number of bytes used by the values in t.
+func (t *StringTable) Size() int {
+	return len(t.buf)
+}
+
+// Strings returns the elements of t as a slice. Equal elements share the same memory.
+func (t *StringTable) Strings() []string {
+	values := make([]string, t.Distinct())
+	for id := range values {
+		values[id] = string(t.value(uint32(id)))
+	}
+
+	a := make([]string, len(t.ids))
+	for i, id := range t.ids {
+		a[i] = values[id]
+	}
+
+	return a
+}
+
+// Unique returns a new table with duplicate values removed, in order of first appearance.
+func (t *StringTable) Unique() *StringTable {
+	seen := make([]bool, t.Distinct())
+
+	return t.subset(func(id uint32) bool {
+		if seen[id] {
+			return false
+		}
+		seen[id] = true
+		return true
+	})
+}
+
+// subset returns a new table with the elements of t where keep(id) == true.
+func (t *StringTable) subset(keep func(id uint32) bool) *StringTable {
+	var (
+		nt    StringTable
+		remap = make(map[uint32]uint32)
+	)
+
+	for _, id := range t.ids {
+		if !keep(id) {
+			continue
+		}
+		nid, ok := remap[id]
+		if !ok {
+			nid = nt.add(string(t.value(id)))
+			remap[id] = nid
+		}
+		nt.ids = append(nt.ids, nid)
+	}
+
+	return &nt
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+// strData returns the address of the bytes of s.
+func strData(s string) uintptr {
+	return (*[2]uintptr)(unsafe.Pointer(&s))[0]
+}
+
+func TestInterner(t *testing.T) {
+	a := []string{"200", strings.Repeat("20", 1) + "0", "404", string([]byte("200"))}
+
+	var in Interner
+	b := in.InternAll(a)
+	if !Equal(a, b) {
+		t.Fatalf("InternAll() = %q, want %q", b, a)
+	}
+	if in.Len() != 2 {
+		t.Errorf("Len() = %v, want 2", in.Len())
+	}
+	if strData(b[0]) != strData(b[1]) || strData(b[0]) != strData(b[3]) {
+		t.Error("InternAll() equal values don't share memory")
+	}
+	if s := in.Intern(string([]byte("404"))); strData(s) != strData(b[2]) {
+		t.Error("Intern() did not return the canonical copy")
+	}
+
+	buf := "GET /index.html HTTP/1.1"
+	if s := in.Intern(buf[:3]); s != "GET" || strData(s) == strData(buf) {
+		t.Error("Intern() kept a reference to the caller's string")
+	}
+
+	c := InternAll(a)
+	if !Equal(a, c) || strData(c[0]) != strData(c[3]) {
+		t.Errorf("InternAll() = %q", c)
+	}
+}
+
+func TestStringTable(t *testing.T) {
+	a := []string{"GET", "POST", "GET", "", "PUT", "GET", "POST"}
+	tbl := NewStringTable(a)
+
+	if tbl.Len() != len(a) || tbl.Distinct() != 4 || tbl.Size() != len("GETPOSTPUT") {
+		t.Errorf("Len() = %v, Distinct() = %v, Size() = %v", tbl.Len(), tbl.Distinct(), tbl.Size())
+	}
+	if got := tbl.Strings(); !Equal(got, a) {
+		t.Errorf("Strings() = %q, want %q", got, a)
+	}
+	for i := range a {
+		if tbl.At(i) != a[i] || string(tbl.Bytes(i)) != a[i] {
+			t.Errorf("At(%d) = %q, want %q", i, tbl.At(i), a[i])
+		}
+	}
+
+	for _, s := range []string{"GET", "POST", "PUT", "", "DELETE"} {
+		if got, want := tbl.Index(s), Index(a, s); got != want {
+			t.Errorf("Index(%q) = %v, want %v", s, got, want)
+		}
+		if got, want := tbl.Count(s), Count(a, s); got != want {
+			t.Errorf("Count(%q) = %v, want %v", s, got, want)
+		}
+	}
+
+	f := tbl.FilterFunc(func(b []byte) bool { return bytes.HasPrefix(b, []byte("P")) })
+	if got, want := f.Strings(), FilterPrefix(a, "P"); !Equal(got, want) {
+		t.Errorf("FilterFunc() = %q, want %q", got, want)
+	}
+
+	u := tbl.Unique()
+	if got, want := u.Strings(), Unique(append([]string(nil), a...)); !Equal(got, want) {
+		t.Errorf("Unique() = %q, want %q", got, want)
+	}
+
+	var zero StringTable
+	if zero.Len() != 0 || zero.Distinct() != 0 || zero.Index("x") != -1 || zero.Count("x") != 0 {
+		t.Error("zero StringTable is not empty")
+	}
+	zero.Append("x", "x")
+	if zero.Count("x") != 2 || zero.Distinct() != 1 {
+		t.Errorf("Append() = %q", zero.Strings())
+	}
+	if got := zero.Unique().Strings(); !Equal(got, []string{"x"}) {
+		t.Errorf("Unique() = %q", got)
+	}
+}
+
+func TestStringTableAllocs(t *testing.T) {
+	tbl := NewStringTable([]string{"GET", "POST", "GET", "PUT"})
+	if n := testing.AllocsPerRun(100, func() { tbl.Index("PUT"); tbl.Count("GET") }); n != 0 {
+		t.Errorf("Index()/Count() allocs = %v, want 0", n)
+	}
+}
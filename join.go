@@ -0,0 +1,223 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Flatten returns a slice with all the strings in values, in order. Each value can be
+// a string, a slice or array of values, or an iterator of values such as
+// func(yield func(string) bool) or an iter.Seq[iter.Seq[string]]; nested values are
+// flattened recursively. Nil values are skipped.
+// It panics if a value is of any other type.
+//
+//	Flatten("a", []string{"b"}, [][]string{{"c"}, {"d"}}) // ["a", "b", "c", "d"]
+func Flatten(values ...interface{}) []string {
+	var a []string
+
+	for _, v := range values {
+		a = flatten(a, v)
+	}
+
+	return a
+}
+
+// flatten appends the strings in v to a and returns the extended slice.
+func flatten(a []string, v interface{}) []string {
+	switch v := v.(type) {
+	case nil:
+		return a
+	case string:
+		return append(a, v)
+	case []string:
+		return append(a, v...)
+	case [][]string:
+		return append(a, Merge(v...)...)
+	case func(yield func(string) bool):
+		if v != nil {
+			v(func(s string) bool {
+				a = append(a, s)
+				return true
+			})
+		}
+		return a
+	}
+
+	return flattenValue(a, reflect.ValueOf(v))
+}
+
+// flattenValue is like flatten, for the types that need reflection.
+func flattenValue(a []string, v reflect.Value) []string {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return a
+
+	case reflect.String:
+		return append(a, v.String())
+
+	case reflect.Interface, reflect.Ptr:
+		if v.IsNil() {
+			return a
+		}
+		return flattenValue(a, v.Elem())
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			a = flattenValue(a, v.Index(i))
+		}
+		return a
+
+	case reflect.Func:
+		t := v.Type()
+		if t.NumIn() != 1 || t.NumOut() != 0 || !isYield(t.In(0)) {
+			break
+		}
+		if v.IsNil() {
+			return a
+		}
+		yield := t.In(0)
+		ok := reflect.ValueOf(true).Convert(yield.Out(0))
+		v.Call([]reflect.Value{reflect.MakeFunc(yield, func(args []reflect.Value) []reflect.Value {
+			a = flattenValue(a, args[0])
+			return []reflect.Value{ok}
+		})})
+		return a
+	}
+
+	panic(fmt.Sprintf("slices: Flatten of unsupported type %s", v.Type()))
+}
+
+// isYield returns true if t is the type of the yield func of an iterator.
+func isYield(t reflect.Type) bool {
+	return t.Kind() == reflect.Func && t.NumIn() == 1 && t.NumOut() == 1 &&
+		t.Out(0).Kind() == reflect.Bool
+}
+
+// Intersperse returns a new slice with sep inserted between each element of a.
+//
+//	Intersperse([]string{"a", "b", "c"}, "+") // ["a", "+", "b", "+", "c"]
+func Intersperse(a []string, sep string) []string {
+	if len(a) == 0 {
+		return nil
+	}
+
+	b := make([]string, 0, 2*len(a)-1)
+	for i := range a {
+		if i > 0 {
+			b = append(b, sep)
+		}
+		b = append(b, a[i])
+	}
+
+	return b
+}
+
+// JoinWith returns a new slice with the subslices of aa joined together, with the
+// elements of sep inserted between them. It is the inverse of Split for a
+// non-empty sep. An empty sep makes Split return each element on its own, and
+// those are joined back without a separator:
+//
+//	JoinWith(Split(a, sep), sep) // equal to a, if sep != ""
+//	JoinWith(Split(a, ""))       // equal to a
+func JoinWith(aa [][]string, sep ...string) []string {
+	if len(aa) == 0 {
+		return nil
+	}
+
+	total := len(sep) * (len(aa) - 1)
+	for i := range aa {
+		total += len(aa[i])
+	}
+	if total == 0 {
+		return nil
+	}
+
+	a := make([]string, 0, total)
+	for i := range aa {
+		if i > 0 {
+			a = append(a, sep...)
+		}
+		a = append(a, aa[i]...)
+	}
+
+	return a
+}
+
+// ListFormat describes how to join the elements of a list into text for people to read.
+// Pair is placed between the elements of a list of two. In longer lists, Sep is placed
+// between the elements, except for Last between the last two.
+type ListFormat struct {
+	Sep, Pair, Last string
+}
+
+// listFormats are the "and" and "or" list formats by language.
+var listFormats = map[string][2]ListFormat{
+	"en":    {{", ", " and ", ", and "}, {", ", " or ", ", or "}},
+	"en-GB": {{", ", " and ", " and "}, {", ", " or ", " or "}},
+	"de":    {{", ", " und ", " und "}, {", ", " oder ", " oder "}},
+	"es":    {{", ", " y ", " y "}, {", ", " o ", " o "}},
+	"fr":    {{", ", " et ", " et "}, {", ", " ou ", " ou "}},
+	"it":    {{", ", " e ", " e "}, {", ", " o ", " o "}},
+	"nl":    {{", ", " en ", " en "}, {", ", " of ", " of "}},
+	"pt":    {{", ", " e ", " e "}, {", ", " ou ", " ou "}},
+	"ja":    {{"、", "、", "、"}, {"、", "または", "、または"}},
+	"zh":    {{"、", "和", "和"}, {"、", "或", "或"}},
+}
+
+// ListFormatOf returns the "and" list format for the language lang, or the "or"
+// format if or is true. The language is a BCP 47 tag such as "en", "en-GB" or "pt_BR";
+// if there is no format for the tag, its base language is used, and then English.
+func ListFormatOf(lang string, or bool) ListFormat {
+	base, region := strings.ToLower(lang), ""
+	if i := strings.IndexAny(base, "-_"); i >= 0 {
+		base, region = base[:i], strings.ToUpper(base[i+1:])
+	}
+
+	f, ok := listFormats[base+"-"+region]
+	if !ok {
+		if f, ok = listFormats[base]; !ok {
+			f = listFormats["en"]
+		}
+	}
+
+	if or {
+		return f[1]
+	}
+
+	return f[0]
+}
+
+// Join returns the elements of a joined as described by f.
+func (f ListFormat) Join(a []string) string {
+	switch len(a) {
+	case 0:
+		return ""
+	case 1:
+		return a[0]
+	case 2:
+		return a[0] + f.Pair + a[1]
+	}
+
+	n := len(a) - 1
+	return strings.Join(a[:n], f.Sep) + f.Last + a[n]
+}
+
+// JoinStrings returns the elements of a joined into a list for people to read,
+// using the "and" format of the language lang. See ListFormatOf.
+//
+//	JoinStrings([]string{"a", "b", "c"}, "en")    // "a, b, and c"
+//	JoinStrings([]string{"a", "b", "c"}, "en-GB") // "a, b and c"
+func JoinStrings(a []string, lang string) string {
+	return ListFormatOf(lang, false).Join(a)
+}
+
+// JoinStringsOr is like JoinStrings, but uses the "or" format of the language.
+//
+//	JoinStringsOr([]string{"a", "b", "c"}, "es") // "a, b o c"
+func JoinStringsOr(a []string, lang string) string {
+	return ListFormatOf(lang, true).Join(a)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"testing"
+)
+
+// stringSeq is a named iterator type, like iter.Seq[string].
+type stringSeq func(yield func(string) bool)
+
+func TestFlatten(t *testing.T) {
+	seq := func(a ...string) stringSeq {
+		return func(yield func(string) bool) {
+			for _, s := range a {
+				if !yield(s) {
+					return
+				}
+			}
+		}
+	}
+	seqs := func(yield func(stringSeq) bool) {
+		_ = yield(seq("d", "e")) && yield(seq("f"))
+	}
+	var nilSeq func(yield func(string) bool)
+
+	tests := []struct {
+		in  []interface{}
+		out []string
+	}{
+		{in: nil, out: nil},
+		{in: []interface{}{"a", nil, []string{"b", "c"}}, out: []string{"a", "b", "c"}},
+		{in: []interface{}{[][]string{{"a"}, nil, {"b"}}, [2]string{"c", "d"}}, out: []string{"a", "b", "c", "d"}},
+		{in: []interface{}{[]interface{}{"a", []interface{}{"b", [][]string{{"c"}}}}}, out: []string{"a", "b", "c"}},
+		{in: []interface{}{seq("a", "b"), seqs, nilSeq}, out: []string{"a", "b", "d", "e", "f"}},
+		{in: []interface{}{NewVector([]string{"a"}).All(), NewOrderedSet([]string{"b"}).All()}, out: []string{"a", "b"}},
+	}
+	for _, tc := range tests {
+		if out := Flatten(tc.in...); !Equal(out, tc.out) {
+			t.Errorf("Flatten(%v) = %q, want %q", tc.in, out, tc.out)
+		}
+	}
+
+	for _, v := range []interface{}{1, []int{1}, func(int) {}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Flatten(%T) didn't panic", v)
+				}
+			}()
+			Flatten(v)
+		}()
+	}
+}
+
+func TestIntersperse(t *testing.T) {
+	tests := []struct {
+		in, out []string
+	}{
+		{in: nil, out: nil},
+		{in: []string{"a"}, out: []string{"a"}},
+		{in: []string{"a", "b", "c"}, out: []string{"a", "+", "b", "+", "c"}},
+	}
+	for _, tc := range tests {
+		if out := Intersperse(tc.in, "+"); !Equal(out, tc.out) {
+			t.Errorf("Intersperse(%q) = %q, want %q", tc.in, out, tc.out)
+		}
+	}
+}
+
+func TestJoinWith(t *testing.T) {
+	tests := []struct {
+		in  [][]string
+		sep []string
+		out []string
+	}{
+		{in: nil, sep: []string{"x"}, out: nil},
+		{in: [][]string{nil}, sep: []string{"x"}, out: nil},
+		{in: [][]string{nil, nil}, sep: []string{"x"}, out: []string{"x"}},
+		{in: [][]string{{"a"}, {"b", "c"}}, sep: nil, out: []string{"a", "b", "c"}},
+		{in: [][]string{{"a"}, {"b"}, {}}, sep: []string{"x", "y"}, out: []string{"a", "x", "y", "b", "x", "y"}},
+	}
+	for _, tc := range tests {
+		if out := JoinWith(tc.in, tc.sep...); !Equal(out, tc.out) {
+			t.Errorf("JoinWith(%q, %q) = %q, want %q", tc.in, tc.sep, out, tc.out)
+		}
+	}
+
+	a := []string{"Pig", "and", "Ale", "and", "", "and"}
+	if out := JoinWith(Split(a, "and"), "and"); !Equal(out, a) {
+		t.Errorf("JoinWith(Split()) = %q, want %q", out, a)
+	}
+}
+
+func TestJoinStrings(t *testing.T) {
+	abc := []string{"a", "b", "c"}
+
+	tests := []struct {
+		in   []string
+		lang string
+		or   bool
+		out  string
+	}{
+		{in: nil, lang: "en", out: ""},
+		{in: []string{"a"}, lang: "en", out: "a"},
+		{in: []string{"a", "b"}, lang: "en", out: "a and b"},
+		{in: abc, lang: "en", out: "a, b, and c"},
+		{in: abc, lang: "en", or: true, out: "a, b, or c"},
+		{in: abc, lang: "en-GB", out: "a, b and c"},
+		{in: abc, lang: "en_gb", out: "a, b and c"},
+		{in: abc, lang: "en-US", out: "a, b, and c"},
+		{in: abc, lang: "pt-BR", out: "a, b e c"},
+		{in: abc, lang: "ES", or: true, out: "a, b o c"},
+		{in: abc, lang: "de", out: "a, b und c"},
+		{in: abc, lang: "ja", out: "a、b、c"},
+		{in: abc, lang: "zh", or: true, out: "a、b或c"},
+		{in: abc, lang: "xx", out: "a, b, and c"},
+		{in: abc, lang: "", out: "a, b, and c"},
+	}
+	for _, tc := range tests {
+		out := JoinStrings(tc.in, tc.lang)
+		if tc.or {
+			out = JoinStringsOr(tc.in, tc.lang)
+		}
+		if out != tc.out {
+			t.Errorf("JoinStrings(%q, %q, or=%v) = %q, want %q", tc.in, tc.lang, tc.or, out, tc.out)
+		}
+	}
+
+	f := ListFormat{Sep: "; ", Pair: " & ", Last: "; & "}
+	if out := f.Join(abc); out != "a; b; & c" {
+		t.Errorf("ListFormat.Join() = %q", out)
+	}
+}
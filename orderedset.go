@@ -0,0 +1,134 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"container/list"
+)
+
+// OrderedSet is a set of strings that keeps the order in which values were added.
+// Add, Has, Remove and the Move methods take constant time.
+//
+// The zero value is an empty set ready to use. An OrderedSet is not safe for
+// concurrent use.
+type OrderedSet struct {
+	items map[string]*list.Element
+	order list.List
+}
+
+// NewOrderedSet returns an OrderedSet with the unique elements of a, in order
+// of first appearance.
+func NewOrderedSet(a []string) *OrderedSet {
+	s := &OrderedSet{items: make(map[string]*list.Element, len(a))}
+	s.Add(a...)
+
+	return s
+}
+
+// Add adds each value to the end of the set, unless it is already in the set.
+// It returns the number of values added.
+func (s *OrderedSet) Add(values ...string) int {
+	if s.items == nil {
+		s.items = make(map[string]*list.Element)
+	}
+
+	var n int
+
+	for _, v := range values {
+		if _, ok := s.items[v]; ok {
+			continue
+		}
+		s.items[v] = s.order.PushBack(v)
+		n++
+	}
+
+	return n
+}
+
+// All returns a sequence of the values in the set, in order. The set must not be
+// changed during the iteration, except to remove the current value.
+func (s *OrderedSet) All() func(yield func(string) bool) {
+	return func(yield func(string) bool) {
+		for e := s.order.Front(); e != nil; {
+			next := e.Next()
+			if !yield(e.Value.(string)) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+// Has returns true if v is in the set.
+func (s *OrderedSet) Has(v string) bool {
+	_, ok := s.items[v]
+	return ok
+}
+
+// IndexOf returns the position of v in the set, or -1 if not found.
+// This takes time proportional to the position.
+func (s *OrderedSet) IndexOf(v string) int {
+	e, ok := s.items[v]
+	if !ok {
+		return -1
+	}
+
+	var i int
+
+	for e = e.Prev(); e != nil; e = e.Prev() {
+		i++
+	}
+
+	return i
+}
+
+// Len returns the number of values in the set.
+func (s *OrderedSet) Len() int {
+	return len(s.items)
+}
+
+// MoveToBack moves v to the end of the set, and returns true if v is in the set.
+func (s *OrderedSet) MoveToBack(v string) bool {
+	e, ok := s.items[v]
+	if ok {
+		s.order.MoveToBack(e)
+	}
+
+	return ok
+}
+
+// MoveToFront moves v to the start of the set, and returns true if v is in the set.
+func (s *OrderedSet) MoveToFront(v string) bool {
+	e, ok := s.items[v]
+	if ok {
+		s.order.MoveToFront(e)
+	}
+
+	return ok
+}
+
+// Remove removes v from the set, and returns true if v was in the set.
+func (s *OrderedSet) Remove(v string) bool {
+	e, ok := s.items[v]
+	if ok {
+		s.order.Remove(e)
+		delete(s.items, v)
+	}
+
+	return ok
+}
+
+// Strings returns the values in the set as a new slice, in order.
+func (s *OrderedSet) Strings() []string {
+	if len(s.items) == 0 {
+		return nil
+	}
+
+	a := make([]string, 0, len(s.items))
+	for e := s.order.Front(); e != nil; e = e.Next() {
+		a = append(a, e.Value.(string))
+	}
+
+	return a
+}
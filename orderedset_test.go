@@ -0,0 +1,78 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"testing"
+)
+
+func TestOrderedSet(t *testing.T) {
+	s := NewOrderedSet([]string{"b", "a", "b", "c"})
+	if got := s.Strings(); !Equal(got, []string{"b", "a", "c"}) {
+		t.Fatalf("Strings() = %q", got)
+	}
+
+	if n := s.Add("a", "d", "e", "d"); n != 2 || s.Len() != 5 {
+		t.Errorf("Add() = %v, Len() = %v", n, s.Len())
+	}
+	if !s.Has("d") || s.Has("x") {
+		t.Error("Has() failed")
+	}
+	if !s.Remove("a") || s.Remove("a") || s.Has("a") {
+		t.Error("Remove() failed")
+	}
+	if !s.MoveToFront("e") || !s.MoveToBack("b") || s.MoveToFront("x") {
+		t.Error("Move failed")
+	}
+	if got := s.Strings(); !Equal(got, []string{"e", "c", "d", "b"}) {
+		t.Errorf("Strings() = %q", got)
+	}
+
+	tests := []struct {
+		in  string
+		out int
+	}{
+		{in: "e", out: 0},
+		{in: "d", out: 2},
+		{in: "b", out: 3},
+		{in: "a", out: -1},
+	}
+	for _, tc := range tests {
+		if out := s.IndexOf(tc.in); out != tc.out {
+			t.Errorf("IndexOf(%q) = %v, want %v", tc.in, out, tc.out)
+		}
+	}
+
+	s.Add("a")
+	if got := s.Strings(); !Equal(got, []string{"e", "c", "d", "b", "a"}) {
+		t.Errorf("Strings() after re-Add = %q", got)
+	}
+}
+
+func TestOrderedSetAll(t *testing.T) {
+	s := NewOrderedSet([]string{"a", "b", "c", "d"})
+
+	var got []string
+	s.All()(func(v string) bool {
+		got = append(got, v)
+		s.Remove(v)
+		return v != "c"
+	})
+	if !Equal(got, []string{"a", "b", "c"}) || !Equal(s.Strings(), []string{"d"}) {
+		t.Errorf("All() = %q, Strings() = %q", got, s.Strings())
+	}
+
+	var zero OrderedSet
+	if zero.Len() != 0 || zero.Strings() != nil || zero.Has("") || zero.IndexOf("") != -1 {
+		t.Error("zero OrderedSet is not empty")
+	}
+	zero.All()(func(string) bool {
+		t.Error("zero OrderedSet yielded a value")
+		return true
+	})
+	zero.Add("")
+	if !zero.Has("") {
+		t.Error("Add() to zero OrderedSet failed")
+	}
+}
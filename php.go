@@ -0,0 +1,327 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Ports of PHP array_* functions. Where PHP uses array keys, these use the
+// element indexes of the slice.
+
+// UniqueFlag selects how UniqueFlags compares elements, like the flags of PHP's array_unique.
+type UniqueFlag int
+
+const (
+	// UniqueString compares elements as strings, like SORT_STRING.
+	UniqueString UniqueFlag = iota
+
+	// UniqueNumeric compares elements as numbers, like SORT_NUMERIC.
+	// Elements are converted to numbers like a PHP (float) cast: the leading
+	// numeric part is used, and elements without one are 0.
+	UniqueNumeric
+
+	// UniqueRegular compares elements with PHP's loose comparison, like SORT_REGULAR.
+	// Numeric strings are compared as numbers, other strings as strings.
+	UniqueRegular
+)
+
+// phpNumeric matches a PHP 8 numeric string, without the surrounding white space.
+var phpNumeric = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+)?`)
+
+// phpSpace is the white space PHP allows around numeric strings.
+const phpSpace = " \t\n\r\v\f"
+
+// phpNumber returns the value of the numeric string s, and whether s is numeric.
+func phpNumber(s string) (float64, bool) {
+	s = strings.Trim(s, phpSpace)
+
+	if m := phpNumeric.FindString(s); m != "" && len(m) == len(s) {
+		f, err := strconv.ParseFloat(m, 64)
+		return f, err == nil || math.IsInf(f, 0)
+	}
+
+	return 0, false
+}
+
+// phpFloat returns s converted to a number like a PHP (float) cast.
+func phpFloat(s string) float64 {
+	m := phpNumeric.FindString(strings.TrimLeft(s, phpSpace))
+	f, _ := strconv.ParseFloat(m, 64)
+
+	return f
+}
+
+// Column returns a slice with the element at index idx of each row in aa, like PHP's
+// array_column. Rows too short to have an element at idx are skipped.
+func Column(aa [][]string, idx int) []string {
+	if idx < 0 {
+		return nil
+	}
+
+	a := make([]string, 0, len(aa))
+	for _, row := range aa {
+		if idx < len(row) {
+			a = append(a, row[idx])
+		}
+	}
+
+	if len(a) == 0 {
+		return nil
+	}
+
+	return a
+}
+
+// DiffAssoc returns the elements of a that differ from the element of b at the
+// same index, like PHP's array_diff_assoc. Elements of a past the end of b are
+// included. Use DiffIndices to get their indexes.
+func DiffAssoc(a, b []string) []string {
+	return assoc(a, b, false)
+}
+
+// DiffKey returns the elements of a whose index doesn't exist in b, like PHP's
+// array_diff_key. With slice indexes this is the part of a past len(b).
+func DiffKey(a, b []string) (res []string) {
+	if auditEnabled {
+		defer audit("DiffKey", a, b)(&res)
+	}
+
+	if len(a) <= len(b) {
+		return nil
+	}
+
+	return append([]string(nil), a[len(b):]...)
+}
+
+// IntersectAssoc returns the elements of a that are equal to the element of b at the
+// same index, like PHP's array_intersect_assoc.
+func IntersectAssoc(a, b []string) []string {
+	return assoc(a, b, true)
+}
+
+// IntersectKey returns the elements of a whose index exists in b, like PHP's
+// array_intersect_key. With slice indexes this is the first len(b) elements of a.
+func IntersectKey(a, b []string) (res []string) {
+	if auditEnabled {
+		defer audit("IntersectKey", a, b)(&res)
+	}
+
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	if n == 0 {
+		return nil
+	}
+
+	return append([]string(nil), a[:n]...)
+}
+
+// assoc returns the elements of a where the comparison with the element of b at
+// the same index is equal to eq. Indexes past the end of b compare unequal.
+func assoc(a, b []string, eq bool) []string {
+	var res []string
+
+	for i := range a {
+		if (i < len(b) && a[i] == b[i]) == eq {
+			res = append(res, a[i])
+		}
+	}
+
+	return res
+}
+
+// IndexLoose returns the index of the first element in a equal to s under PHP's loose
+// comparison, or -1 if not found. This is PHP's array_search with strict false;
+// Index is the strict version.
+//
+// Numeric strings are compared as numbers, so "10", "1e1", "10.0" and " 10" are equal.
+// Other strings are compared byte by byte.
+func IndexLoose(a []string, s string) int {
+	n, ok := phpNumber(s)
+	if !ok {
+		return Index(a, s)
+	}
+
+	return IndexFunc(a, func(v string) bool {
+		m, ok := phpNumber(v)
+		return ok && m == n
+	})
+}
+
+// Indices returns the indexes of all the instances of s in a, like PHP's array_keys
+// with a search value. It returns nil if s is not found.
+func Indices(a []string, s string) []int {
+	return IndicesFunc(a, ValueEquals(s))
+}
+
+// IndicesFunc returns the indexes of all the elements in a where f(s) == true.
+// It returns nil if there are none.
+func IndicesFunc(a []string, f ValueFunc) []int {
+	var idx []int
+
+	for i := range a {
+		if f(a[i]) {
+			idx = append(idx, i)
+		}
+	}
+
+	return idx
+}
+
+// Pad returns a copy of a padded with value to size elements, like PHP's array_pad.
+// If size > 0 the slice is padded on the right, if size < 0 on the left.
+// If the absolute value of size is not more than len(a), no padding takes place.
+func Pad(a []string, size int, value string) (b []string) {
+	if auditEnabled {
+		defer audit("Pad", a)(&b)
+	}
+
+	n := size
+	if n < 0 {
+		n = -n
+	}
+
+	m := len(a)
+	if n <= m {
+		return append([]string(nil), a...)
+	}
+
+	b = make([]string, n)
+	if size > 0 {
+		copy(b, a)
+		for i := m; i < n; i++ {
+			b[i] = value
+		}
+		return b
+	}
+
+	for i := 0; i < n-m; i++ {
+		b[i] = value
+	}
+	copy(b[n-m:], a)
+
+	return b
+}
+
+// Range returns a slice with a range of elements from start to end, inclusive,
+// like PHP's range. The range counts down if start > end.
+//
+// If start and end are integers, the elements are integers spaced by step.
+// Otherwise the range is over the bytes of the first character of each, so
+// Range("a", "e", 2) returns ["a", "c", "e"]; an empty start or end is treated
+// as "0", as in PHP.
+//
+// The absolute value of step is used. It panics if step is 0, or if the range
+// would have more than 1<<24 elements.
+func Range(start, end string, step int) []string {
+	if step == 0 {
+		panic("slices: zero Range step")
+	}
+	abs := uint64(step)
+	if step < 0 {
+		abs = -abs
+	}
+
+	if i, err := strconv.Atoi(start); err == nil {
+		if j, err := strconv.Atoi(end); err == nil {
+			return rangeFunc(i, j, abs, strconv.Itoa)
+		}
+	}
+
+	if start == "" {
+		start = "0"
+	}
+	if end == "" {
+		end = "0"
+	}
+
+	return rangeFunc(int(start[0]), int(end[0]), abs, func(c int) string {
+		return string([]byte{byte(c)})
+	})
+}
+
+// rangeMax is the maximum number of elements returned by Range.
+const rangeMax = 1 << 24
+
+// rangeFunc returns format(v) for each v from i to j, inclusive, spaced by step.
+// The span is computed in unsigned arithmetic, which can't overflow for any i and
+// j, and is checked against rangeMax before the count is derived from it.
+func rangeFunc(i, j int, step uint64, format func(int) string) []string {
+	span := uint64(j) - uint64(i)
+	if i > j {
+		span = uint64(i) - uint64(j)
+	}
+
+	if span/step >= rangeMax {
+		panic("slices: Range too large")
+	}
+
+	a := make([]string, span/step+1)
+	for k := range a {
+		d := uint64(k) * step
+		if i > j {
+			d = -d
+		}
+		a[k] = format(int(uint64(i) + d))
+	}
+
+	return a
+}
+
+// UniqueFlags returns a new slice with duplicate values removed, comparing elements
+// as selected by flag, like PHP's array_unique. The first of equal elements is kept.
+// The slice a is not modified.
+func UniqueFlags(a []string, flag UniqueFlag) []string {
+	key := func(v string) string { return v }
+
+	switch flag {
+	case UniqueNumeric:
+		key = func(v string) string {
+			return strconv.FormatFloat(phpFloat(v), 'g', -1, 64)
+		}
+	case UniqueRegular:
+		key = func(v string) string {
+			if n, ok := phpNumber(v); ok {
+				return "n" + strconv.FormatFloat(n, 'g', -1, 64)
+			}
+			return "s" + v
+		}
+	}
+
+	seen := make(map[string]struct{}, len(a))
+
+	return FilterFunc(a, func(v string) bool {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			return false
+		}
+		seen[k] = struct{}{}
+		return true
+	})
+}
+
+// WalkRecursive applies the f func to each element in the rows of aa, like PHP's
+// array_walk_recursive with a by-reference callback. Func f can change the element
+// through val. Note that this function will change the slices in aa.
+func WalkRecursive(aa [][]string, f func(row, col int, val *string)) {
+	for i := range aa {
+		for j := range aa[i] {
+			f(i, j, &aa[i][j])
+		}
+	}
+}
+
+// WalkRef applies the f func to each element in a, like PHP's array_walk with a
+// by-reference callback. Func f can change the element through val.
+// Note that this function will change the slice a.
+func WalkRef(a []string, f func(idx int, val *string)) {
+	for idx := range a {
+		f(idx, &a[idx])
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// phpCase is a conformance case from testdata/php_slice.json, with the result
+// PHP's array_slice or array_splice gives for the same arguments.
+// A null length is passed to Slice as 0, and to Splice as len(input). Slice has
+// no way to ask for PHP's zero length, so array_slice cases with length 0 only
+// check that Slice treats it as null.
+type phpCase struct {
+	Func        string   `json:"func"`
+	Input       []string `json:"input"`
+	Offset      int      `json:"offset"`
+	Length      *int     `json:"length"`
+	Replacement []string `json:"replacement"`
+	Want        []string `json:"want"`
+}
+
+func loadPHPCases(t *testing.T) []phpCase {
+	data, err := ioutil.ReadFile("testdata/php_slice.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var cases []phpCase
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatal(err)
+	}
+
+	return cases
+}
+
+func TestPHPConformance(t *testing.T) {
+	for _, tc := range loadPHPCases(t) {
+		in := append([]string(nil), tc.Input...)
+
+		var got, gotInPlace []string
+		switch tc.Func {
+		case "array_slice":
+			length := 0
+			if tc.Length != nil {
+				length = *tc.Length
+			}
+			if tc.Length != nil && length == 0 {
+				if want := Slice(in, tc.Offset, len(in)); !Equal(Slice(in, tc.Offset, 0), want) {
+					t.Errorf("Slice(%q, %d, 0) = %q, want %q", in, tc.Offset, Slice(in, tc.Offset, 0), want)
+				}
+				continue
+			}
+			got = Slice(in, tc.Offset, length)
+			gotInPlace = SliceView(in, tc.Offset, length)
+
+		case "array_splice":
+			length := len(in)
+			if tc.Length != nil {
+				length = *tc.Length
+			}
+			got = Splice(in, tc.Offset, length, tc.Replacement...)
+			if !Equal(in, tc.Input) {
+				t.Errorf("%s(%q, %d, %v) modified its input: %q", tc.Func, tc.Input, tc.Offset, tc.Length, in)
+			}
+			gotInPlace = SpliceInPlace(append([]string(nil), tc.Input...), tc.Offset, length, tc.Replacement...)
+
+		default:
+			t.Fatalf("unknown func %q", tc.Func)
+		}
+
+		if !Equal(got, tc.Want) {
+			t.Errorf("%s(%q, %d, %v, %q) = %q, want %q",
+				tc.Func, tc.Input, tc.Offset, lengthString(tc.Length), tc.Replacement, got, tc.Want)
+		}
+		if !Equal(gotInPlace, tc.Want) {
+			t.Errorf("%s(%q, %d, %v, %q) in place = %q, want %q",
+				tc.Func, tc.Input, tc.Offset, lengthString(tc.Length), tc.Replacement, gotInPlace, tc.Want)
+		}
+		if overlaps(got, in) {
+			t.Errorf("%s(%q, %d, %v) result shares memory with its input", tc.Func, tc.Input, tc.Offset, lengthString(tc.Length))
+		}
+	}
+}
+
+func lengthString(length *int) string {
+	if length == nil {
+		return "null"
+	}
+
+	b, _ := json.Marshal(*length)
+
+	return string(b)
+}
+
+func TestSpliceInPlace(t *testing.T) {
+	a := make([]string, 4, 8)
+	copy(a, []string{"a", "b", "c", "d"})
+
+	got := SpliceInPlace(a, 1, 1, "x", "y")
+	if !Equal(got, []string{"a", "x", "y", "c", "d"}) || &got[0] != &a[0] {
+		t.Errorf("SpliceInPlace() = %q, sharing %v", got, &got[0] == &a[0])
+	}
+
+	got = SpliceInPlace(got, 1, 3)
+	if !Equal(got, []string{"a", "d"}) || a[2] != "" || a[3] != "" {
+		t.Errorf("SpliceInPlace() = %q, backing array %q", got, a[:4])
+	}
+
+	if s := SliceView(a, 0, 1); &s[0] != &a[0] || cap(s) != 1 {
+		t.Errorf("SliceView() did not alias a with limited capacity")
+	}
+}
+
+func TestPad(t *testing.T) {
+	a := []string{"12", "10", "9"}
+	tests := []struct {
+		size int
+		out  []string
+	}{
+		{size: 5, out: []string{"12", "10", "9", "0", "0"}},
+		{size: -7, out: []string{"0", "0", "0", "0", "12", "10", "9"}},
+		{size: 2, out: []string{"12", "10", "9"}},
+		{size: -3, out: []string{"12", "10", "9"}},
+		{size: 0, out: []string{"12", "10", "9"}},
+	}
+	for _, tc := range tests {
+		out := Pad(a, tc.size, "0")
+		if !Equal(out, tc.out) || overlaps(out, a) {
+			t.Errorf("Pad(%d) = %q, want %q", tc.size, out, tc.out)
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		start, end string
+		step       int
+		out        []string
+	}{
+		{start: "0", end: "5", step: 1, out: []string{"0", "1", "2", "3", "4", "5"}},
+		{start: "0", end: "100", step: 25, out: []string{"0", "25", "50", "75", "100"}},
+		{start: "10", end: "1", step: 3, out: []string{"10", "7", "4", "1"}},
+		{start: "-2", end: "2", step: -2, out: []string{"-2", "0", "2"}},
+		{start: "a", end: "e", step: 1, out: []string{"a", "b", "c", "d", "e"}},
+		{start: "z", end: "w", step: 1, out: []string{"z", "y", "x", "w"}},
+		{start: "a", end: "i", step: 4, out: []string{"a", "e", "i"}},
+		{start: "apple", end: "cherry", step: 1, out: []string{"a", "b", "c"}},
+		{start: "3", end: "3", step: 1, out: []string{"3"}},
+		{start: "9223372036854775800", end: "9223372036854775807", step: 5, out: []string{"9223372036854775800", "9223372036854775805"}},
+		{start: "-9223372036854775807", end: "-9223372036854775808", step: 5, out: []string{"-9223372036854775807"}},
+		{start: "-9223372036854775808", end: "9223372036854775807", step: -9223372036854775808, out: []string{"-9223372036854775808", "0"}},
+	}
+	for _, tc := range tests {
+		if out := Range(tc.start, tc.end, tc.step); !Equal(out, tc.out) {
+			t.Errorf("Range(%q, %q, %d) = %q, want %q", tc.start, tc.end, tc.step, out, tc.out)
+		}
+	}
+
+	for _, tc := range []struct {
+		start, end string
+		step       int
+	}{
+		{start: "a", end: "z", step: 0},
+		{start: "0", end: "9223372036854775807", step: 1},
+		{start: "-9223372036854775808", end: "9223372036854775807", step: 1},
+		{start: "9223372036854775807", end: "-9223372036854775808", step: -1},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Range(%q, %q, %d) did not panic", tc.start, tc.end, tc.step)
+				}
+			}()
+			Range(tc.start, tc.end, tc.step)
+		}()
+	}
+}
+
+func TestIndexLoose(t *testing.T) {
+	a := []string{"blue", "1e1", "red", " 7", "0.50"}
+	tests := []struct {
+		in          string
+		loose, want int
+	}{
+		{in: "red", loose: 2, want: 2},
+		{in: "10", loose: 1, want: -1},
+		{in: "10.0", loose: 1, want: -1},
+		{in: "7", loose: 3, want: -1},
+		{in: ".5", loose: 4, want: -1},
+		{in: "1e1", loose: 1, want: 1},
+		{in: "Red", loose: -1, want: -1},
+		{in: "0", loose: -1, want: -1},
+	}
+	for _, tc := range tests {
+		if out := IndexLoose(a, tc.in); out != tc.loose {
+			t.Errorf("IndexLoose(%q) = %v, want %v", tc.in, out, tc.loose)
+		}
+		if out := Index(a, tc.in); out != tc.want {
+			t.Errorf("Index(%q) = %v, want %v", tc.in, out, tc.want)
+		}
+	}
+}
+
+func TestIndices(t *testing.T) {
+	a := []string{"blue", "red", "green", "blue", "blue"}
+	if out := Indices(a, "blue"); !reflect.DeepEqual(out, []int{0, 3, 4}) {
+		t.Errorf("Indices() = %v", out)
+	}
+	if out := Indices(a, "black"); out != nil {
+		t.Errorf("Indices() = %v, want nil", out)
+	}
+	if out := IndicesFunc(a, ValueHasPrefix("g").Or(ValueEquals("red"))); !reflect.DeepEqual(out, []int{1, 2}) {
+		t.Errorf("IndicesFunc() = %v", out)
+	}
+}
+
+func TestKeyFuncs(t *testing.T) {
+	a, b := []string{"a", "b", "c", "d"}, []string{"x", "y"}
+	if out := IntersectKey(a, b); !Equal(out, []string{"a", "b"}) || overlaps(out, a) {
+		t.Errorf("IntersectKey() = %q", out)
+	}
+	if out := DiffKey(a, b); !Equal(out, []string{"c", "d"}) || overlaps(out, a) {
+		t.Errorf("DiffKey() = %q", out)
+	}
+	if out := DiffKey(b, a); out != nil {
+		t.Errorf("DiffKey() = %q, want nil", out)
+	}
+	if out := IntersectKey(nil, a); out != nil {
+		t.Errorf("IntersectKey() = %q, want nil", out)
+	}
+}
+
+func TestColumn(t *testing.T) {
+	rows := [][]string{{"1", "John", "Doe"}, {"2", "Sally"}, {"3", "Jane", "Jones"}}
+	if out := Column(rows, 2); !Equal(out, []string{"Doe", "Jones"}) {
+		t.Errorf("Column(2) = %q", out)
+	}
+	if out := Column(rows, 0); !Equal(out, []string{"1", "2", "3"}) {
+		t.Errorf("Column(0) = %q", out)
+	}
+	if out := Column(rows, 5); out != nil {
+		t.Errorf("Column(5) = %q, want nil", out)
+	}
+}
+
+func TestUniqueFlags(t *testing.T) {
+	a := []string{"4", "04", "4.0", "4abc", "a", "A", "a", " 4", "0", "xyz"}
+	tests := []struct {
+		flag UniqueFlag
+		out  []string
+	}{
+		{flag: UniqueString, out: []string{"4", "04", "4.0", "4abc", "a", "A", " 4", "0", "xyz"}},
+		{flag: UniqueNumeric, out: []string{"4", "a"}},
+		{flag: UniqueRegular, out: []string{"4", "4abc", "a", "A", "0", "xyz"}},
+	}
+	for _, tc := range tests {
+		in := append([]string(nil), a...)
+		if out := UniqueFlags(in, tc.flag); !Equal(out, tc.out) {
+			t.Errorf("UniqueFlags(%v) = %q, want %q", tc.flag, out, tc.out)
+		}
+		if !Equal(in, a) {
+			t.Errorf("UniqueFlags(%v) modified its input", tc.flag)
+		}
+	}
+}
+
+func TestWalkRef(t *testing.T) {
+	a := []string{"apple", "banana"}
+	WalkRef(a, func(i int, v *string) {
+		*v = strings.ToUpper((*v)[:1]) + (*v)[1:]
+	})
+	if !Equal(a, []string{"Apple", "Banana"}) {
+		t.Errorf("WalkRef() = %q", a)
+	}
+
+	aa := [][]string{{"a", "b"}, {"c"}}
+	WalkRecursive(aa, func(row, col int, v *string) {
+		*v += fmt.Sprintf("%d%d", row, col)
+	})
+	if !reflect.DeepEqual(aa, [][]string{{"a00", "b01"}, {"c10"}}) {
+		t.Errorf("WalkRecursive() = %q", aa)
+	}
+}
+
+func TestAssoc(t *testing.T) {
+	a := []string{"green", "brown", "blue", "red"}
+	b := []string{"green", "yellow", "red"}
+	tests := []struct {
+		name      string
+		a, b      []string
+		diff      []string
+		intersect []string
+		indices   []int
+	}{
+		{name: "php", a: a, b: b,
+			diff: []string{"brown", "blue", "red"}, intersect: []string{"green"}, indices: []int{1, 2, 3}},
+		{name: "same", a: a, b: a,
+			diff: nil, intersect: a, indices: nil},
+		{name: "a shorter", a: b[:2], b: a,
+			diff: []string{"yellow"}, intersect: []string{"green"}, indices: []int{1}},
+		{name: "nil b", a: a, b: nil,
+			diff: a, intersect: nil, indices: []int{0, 1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := DiffAssoc(tt.a, tt.b); !reflect.DeepEqual(out, tt.diff) {
+				t.Errorf("DiffAssoc() = %q, want %q", out, tt.diff)
+			}
+			if out := IntersectAssoc(tt.a, tt.b); !reflect.DeepEqual(out, tt.intersect) {
+				t.Errorf("IntersectAssoc() = %q, want %q", out, tt.intersect)
+			}
+			if out := DiffIndices(tt.a, tt.b); !reflect.DeepEqual(out, tt.indices) {
+				t.Errorf("DiffIndices() = %v, want %v", out, tt.indices)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+// Property tests check laws that must hold for any input, using random slices
+// from testing/quick. The fuzz targets in fuzz_test.go check some of the same laws.
+
+// refSlice is a reference implementation of Slice that follows PHP's array_slice
+// step by step, with a length of 0 standing for a null length as Slice documents.
+func refSlice(a []string, offset, length int) []string {
+	m := len(a)
+	if offset > m {
+		return nil
+	}
+	if offset < 0 {
+		if offset += m; offset < 0 {
+			offset = 0
+		}
+	}
+
+	switch {
+	case length == 0:
+		length = m - offset
+	case length < 0:
+		length = m - offset + length
+	case offset+length > m:
+		length = m - offset
+	}
+
+	var r []string
+	for i := offset; i < offset+length; i++ {
+		r = append(r, a[i])
+	}
+
+	return r
+}
+
+// refSplice is a reference implementation of Splice that follows PHP's array_splice
+// step by step, with a length of 0 removing nothing.
+func refSplice(a []string, offset, length int, b []string) []string {
+	m := len(a)
+	switch {
+	case offset > m:
+		offset = m
+	case offset < 0:
+		if offset += m; offset < 0 {
+			offset = 0
+		}
+	}
+
+	switch {
+	case length < 0:
+		if length = m - offset + length; length < 0 {
+			length = 0
+		}
+	case offset+length > m:
+		length = m - offset
+	}
+
+	var r []string
+	for i := 0; i < offset; i++ {
+		r = append(r, a[i])
+	}
+	r = append(r, b...)
+	for i := offset + length; i < m; i++ {
+		r = append(r, a[i])
+	}
+
+	return r
+}
+
+// small returns n reduced to the range -m-2 .. m+2, so that offsets and lengths
+// hit the interesting cases.
+func small(n int8, m int) int {
+	return int(n) % (m + 3)
+}
+
+func checkProperty(t *testing.T, f interface{}) {
+	t.Helper()
+
+	if err := quick.Check(f, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPropertyReverse(t *testing.T) {
+	checkProperty(t, func(a []string) bool {
+		r := Reverse(append([]string(nil), a...))
+		for i := range a {
+			if r[i] != a[len(a)-1-i] {
+				return false
+			}
+		}
+		return Equal(Reverse(r), a)
+	})
+}
+
+func TestPropertyChunkMerge(t *testing.T) {
+	checkProperty(t, func(a []string, size uint8) bool {
+		n := int(size%8) + 1
+		aa := Chunk(a, n)
+		for i, c := range aa {
+			if len(c) == 0 || len(c) > n || (len(c) < n && i < len(aa)-1) {
+				return false
+			}
+		}
+		return Equal(Merge(aa...), a)
+	})
+}
+
+// joinSep returns the separator that makes JoinWith the inverse of Split by sep.
+func joinSep(sep string) []string {
+	if sep == "" {
+		return nil
+	}
+
+	return []string{sep}
+}
+
+func TestPropertySplitJoin(t *testing.T) {
+	seps := []string{"", "a", "b"}
+
+	checkProperty(t, func(a []byte, n int8) bool {
+		// Use a small alphabet so the separators are found often.
+		s := make([]string, len(a))
+		for i := range a {
+			s[i] = seps[int(a[i])%len(seps)]
+		}
+
+		k := small(n, len(s))
+		for _, sep := range seps {
+			aa := SplitN(s, sep, k)
+			if k == 0 {
+				if aa != nil {
+					return false
+				}
+				continue
+			}
+			if !Equal(JoinWith(aa, joinSep(sep)...), s) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestPropertyDiffIntersect(t *testing.T) {
+	checkProperty(t, func(a, b []string, seed []uint8) bool {
+		// Reuse some elements of a in b, so they intersect.
+		for i, n := range seed {
+			if i < len(b) && len(a) > 0 {
+				b[i] = a[int(n)%len(a)]
+			}
+		}
+
+		diff, inter := Diff(a, b), Intersect(a, b)
+		if len(diff)+len(inter) != len(a) || !SameElements(Merge(diff, inter), a) {
+			return false
+		}
+		if Any(diff, ValueIn(b)) || !All(inter, ValueIn(b)) {
+			return false
+		}
+		return Equal(Diff(a, nil), a) && Intersect(a, nil) == nil && Equal(Diff(a, a), nil)
+	})
+}
+
+func TestPropertySliceSplice(t *testing.T) {
+	checkProperty(t, func(a, b []string, offset, length int8) bool {
+		off, n := small(offset, len(a)), small(length, len(a))
+
+		if !Equal(Slice(a, off, n), refSlice(a, off, n)) {
+			return false
+		}
+		if !Equal(Splice(a, off, n, b...), refSplice(a, off, n, b)) {
+			return false
+		}
+
+		// Splicing a slice back where it came from restores a.
+		if off >= 0 && n > 0 {
+			return Equal(Splice(Splice(a, off, n), off, 0, Slice(a, off, n)...), a)
+		}
+		return true
+	})
+}
+
+func TestPropertyUnique(t *testing.T) {
+	checkProperty(t, func(a []string) bool {
+		u := Unique(append([]string(nil), a...))
+		return Equal(Unique(append([]string(nil), u...)), u) && Equal(NewOrderedSet(a).Strings(), u) &&
+			Equal(UniqueFlags(a, UniqueString), u) && All(a, ValueIn(u))
+	})
+}
+
+func TestPropertyEncode(t *testing.T) {
+	checkProperty(t, func(a []string) bool {
+		if out, err := ParseJSON(FormatJSON(a)); err != nil || !Equal(out, a) {
+			return false
+		}
+		if out, err := ParseShell(FormatShell(a)); err != nil || !Equal(out, a) {
+			return false
+		}
+		if out, err := ParseList(FormatList(a)); err != nil || !Equal(out, a) {
+			return false
+		}
+
+		// encoding/csv turns "\r\n" into "\n" inside quoted fields.
+		if Any(a, ValueContains("\r")) {
+			return true
+		}
+		out, err := ParseCSV(FormatCSV(a))
+		return err == nil && Equal(out, a)
+	})
+}
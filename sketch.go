@@ -0,0 +1,437 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/bits"
+	"sort"
+)
+
+var (
+	// ErrSketchData is returned when unmarshaling invalid sketch data.
+	ErrSketchData = errors.New("slices: invalid sketch data")
+
+	// ErrSketchMismatch is returned when merging sketches with different parameters.
+	ErrSketchMismatch = errors.New("slices: sketch parameters don't match")
+)
+
+// HyperLogLog estimates the number of distinct strings added to it, in fixed
+// memory of 2^precision bytes. The relative error is about 1.04/sqrt(2^precision).
+// It complements Unique and Count, which are exact but use memory proportional
+// to the input.
+type HyperLogLog struct {
+	p   uint8
+	reg []uint8
+}
+
+// NewHyperLogLog returns an empty HyperLogLog with 2^precision registers.
+// It panics if precision is not between 4 and 18.
+func NewHyperLogLog(precision int) *HyperLogLog {
+	if precision < 4 || precision > 18 {
+		panic("slices: HyperLogLog precision out of range")
+	}
+
+	return &HyperLogLog{p: uint8(precision), reg: make([]uint8, 1<<uint(precision))}
+}
+
+// Add adds s to the estimate.
+func (h *HyperLogLog) Add(s string) {
+	x := hashString(s)
+	i := x >> (64 - h.p)
+	w := x<<h.p | 1<<(h.p-1)
+
+	if r := uint8(bits.LeadingZeros64(w) + 1); r > h.reg[i] {
+		h.reg[i] = r
+	}
+}
+
+// AddAll adds all the elements of a to the estimate.
+func (h *HyperLogLog) AddAll(a []string) {
+	for i := range a {
+		h.Add(a[i])
+	}
+}
+
+// AddSeq adds all the values yielded by seq to the estimate.
+// An iter.Seq[string] can be passed as seq.
+func (h *HyperLogLog) AddSeq(seq func(yield func(string) bool)) {
+	seq(func(s string) bool {
+		h.Add(s)
+		return true
+	})
+}
+
+// Count returns the estimated number of distinct strings added.
+func (h *HyperLogLog) Count() uint64 {
+	m := float64(len(h.reg))
+
+	var (
+		sum   float64
+		zeros int
+	)
+	for _, r := range h.reg {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	var alpha float64
+	switch len(h.reg) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+
+	e := alpha * m * m / sum
+	if e <= 2.5*m && zeros > 0 {
+		e = m * math.Log(m/float64(zeros)) // linear counting for small cardinalities
+	}
+
+	return uint64(e + 0.5)
+}
+
+// Merge adds the estimate of o, e.g. from another shard, to h.
+// Both must have the same precision, otherwise ErrSketchMismatch is returned.
+func (h *HyperLogLog) Merge(o *HyperLogLog) error {
+	if h.p != o.p {
+		return ErrSketchMismatch
+	}
+
+	for i, r := range o.reg {
+		if r > h.reg[i] {
+			h.reg[i] = r
+		}
+	}
+
+	return nil
+}
+
+const hllMagic = "SHL1"
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (h *HyperLogLog) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, len(hllMagic)+1+len(h.reg))
+	b = append(b, hllMagic...)
+	b = append(b, h.p)
+
+	return append(b, h.reg...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (h *HyperLogLog) UnmarshalBinary(data []byte) error {
+	const header = len(hllMagic) + 1
+
+	if len(data) < header || string(data[:len(hllMagic)]) != hllMagic {
+		return ErrSketchData
+	}
+
+	p := data[len(hllMagic)]
+	if p < 4 || p > 18 || len(data)-header != 1<<p {
+		return ErrSketchData
+	}
+
+	*h = *NewHyperLogLog(int(p))
+	copy(h.reg, data[header:])
+
+	return nil
+}
+
+// HeavyHitter is a value and its estimated count, as returned by CountMinSketch.TopK.
+type HeavyHitter struct {
+	Value string
+	Count uint64
+}
+
+// CountMinSketch estimates how many times each string was added to it, in
+// fixed memory. Estimates are never lower than the true count, and exceed it by
+// at most epsilon times the total count with probability 1-delta.
+// It can also track the top k most frequent values (heavy hitters).
+type CountMinSketch struct {
+	width, depth uint32
+	counts       []uint64
+	total        uint64
+	k            int
+	top          hitterHeap
+	topIndex     map[string]*hitter
+}
+
+// cmsMaxK is the maximum number of heavy hitters a CountMinSketch tracks.
+const cmsMaxK = 1 << 20
+
+// NewCountMinSketch returns an empty CountMinSketch with error bound epsilon and
+// failure probability delta, that tracks the k most frequent values. If k <= 0
+// no values are tracked, and k is limited to 1<<20.
+// It panics if epsilon or delta are not between 0 and 1.
+func NewCountMinSketch(epsilon, delta float64, k int) *CountMinSketch {
+	if epsilon <= 0 || epsilon >= 1 || delta <= 0 || delta >= 1 {
+		panic("slices: CountMinSketch epsilon or delta out of range")
+	}
+
+	width := uint32(math.Ceil(math.E / epsilon))
+	depth := uint32(math.Ceil(math.Log(1 / delta)))
+
+	return newCountMinSketch(width, depth, k)
+}
+
+func newCountMinSketch(width, depth uint32, k int) *CountMinSketch {
+	switch {
+	case k < 0:
+		k = 0
+	case k > cmsMaxK:
+		k = cmsMaxK
+	}
+
+	return &CountMinSketch{
+		width:    width,
+		depth:    depth,
+		counts:   make([]uint64, uint64(width)*uint64(depth)),
+		k:        k,
+		topIndex: make(map[string]*hitter),
+	}
+}
+
+// Add increments the count of s by one.
+func (cm *CountMinSketch) Add(s string) {
+	cm.AddN(s, 1)
+}
+
+// AddN increments the count of s by n.
+func (cm *CountMinSketch) AddN(s string, n uint64) {
+	h1, h2 := bloomHashes(s)
+
+	est := uint64(math.MaxUint64)
+	for i := uint64(0); i < uint64(cm.depth); i++ {
+		j := i*uint64(cm.width) + (h1+i*h2)%uint64(cm.width)
+		cm.counts[j] += n
+		if cm.counts[j] < est {
+			est = cm.counts[j]
+		}
+	}
+	cm.total += n
+
+	cm.track(s, est)
+}
+
+// AddAll increments the count of each element of a.
+func (cm *CountMinSketch) AddAll(a []string) {
+	for i := range a {
+		cm.Add(a[i])
+	}
+}
+
+// AddSeq increments the count of each value yielded by seq.
+// An iter.Seq[string] can be passed as seq.
+func (cm *CountMinSketch) AddSeq(seq func(yield func(string) bool)) {
+	seq(func(s string) bool {
+		cm.Add(s)
+		return true
+	})
+}
+
+// Estimate returns the estimated count of s.
+func (cm *CountMinSketch) Estimate(s string) uint64 {
+	h1, h2 := bloomHashes(s)
+
+	est := uint64(math.MaxUint64)
+	for i := uint64(0); i < uint64(cm.depth); i++ {
+		if c := cm.counts[i*uint64(cm.width)+(h1+i*h2)%uint64(cm.width)]; c < est {
+			est = c
+		}
+	}
+
+	return est
+}
+
+// Total returns the sum of all counts added.
+func (cm *CountMinSketch) Total() uint64 {
+	return cm.total
+}
+
+// TopK returns the tracked heavy hitters, in descending order of count.
+// Values with equal counts are ordered by value.
+func (cm *CountMinSketch) TopK() []HeavyHitter {
+	hh := make([]HeavyHitter, len(cm.top))
+	for i, h := range cm.top {
+		hh[i] = HeavyHitter{Value: h.value, Count: h.count}
+	}
+
+	sort.Slice(hh, func(i, j int) bool {
+		if hh[i].Count != hh[j].Count {
+			return hh[i].Count > hh[j].Count
+		}
+		return hh[i].Value < hh[j].Value
+	})
+
+	return hh
+}
+
+// track updates the heavy hitters with the estimate est of s.
+func (cm *CountMinSketch) track(s string, est uint64) {
+	if cm.k == 0 {
+		return
+	}
+
+	if h, ok := cm.topIndex[s]; ok {
+		h.count = est
+		heap.Fix(&cm.top, h.index)
+		return
+	}
+
+	if len(cm.top) < cm.k {
+		h := &hitter{value: s, count: est}
+		heap.Push(&cm.top, h)
+		cm.topIndex[s] = h
+		return
+	}
+
+	if low := cm.top[0]; est > low.count {
+		delete(cm.topIndex, low.value)
+		low.value, low.count = s, est
+		heap.Fix(&cm.top, 0)
+		cm.topIndex[s] = low
+	}
+}
+
+// Merge adds the counts of o, e.g. from another shard, to cm.
+// Both must have the same width, depth and k, otherwise ErrSketchMismatch is returned.
+// The heavy hitters of both are re-estimated from the merged counts.
+func (cm *CountMinSketch) Merge(o *CountMinSketch) error {
+	if cm.width != o.width || cm.depth != o.depth || cm.k != o.k {
+		return ErrSketchMismatch
+	}
+
+	for i, c := range o.counts {
+		cm.counts[i] += c
+	}
+	cm.total += o.total
+
+	values := make([]string, 0, len(cm.top)+len(o.top))
+	for _, h := range cm.top {
+		values = append(values, h.value)
+	}
+	for _, h := range o.top {
+		values = append(values, h.value)
+	}
+
+	cm.top, cm.topIndex = cm.top[:0], make(map[string]*hitter, cm.k)
+	for _, s := range values {
+		cm.track(s, cm.Estimate(s))
+	}
+
+	return nil
+}
+
+const cmsMagic = "SCM1"
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (cm *CountMinSketch) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, len(cmsMagic)+24+8*len(cm.counts))
+	b = append(b, cmsMagic...)
+	b = appendUint32(b, cm.width)
+	b = appendUint32(b, cm.depth)
+	b = appendUint64(b, cm.total)
+	b = appendUint32(b, uint32(cm.k))
+	b = appendUint32(b, uint32(len(cm.top)))
+	for _, c := range cm.counts {
+		b = appendUint64(b, c)
+	}
+	for _, h := range cm.top {
+		b = appendUint32(b, uint32(len(h.value)))
+		b = append(b, h.value...)
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (cm *CountMinSketch) UnmarshalBinary(data []byte) error {
+	const header = len(cmsMagic) + 24
+
+	if len(data) < header || string(data[:len(cmsMagic)]) != cmsMagic {
+		return ErrSketchData
+	}
+	data = data[len(cmsMagic):]
+
+	width := binary.BigEndian.Uint32(data)
+	depth := binary.BigEndian.Uint32(data[4:])
+	total := binary.BigEndian.Uint64(data[8:])
+	k := binary.BigEndian.Uint32(data[16:])
+	ntop := binary.BigEndian.Uint32(data[20:])
+	data = data[24:]
+
+	ncounts := uint64(width) * uint64(depth)
+	if width == 0 || depth == 0 || ntop > k || k > cmsMaxK || ncounts > uint64(len(data))/8 {
+		return ErrSketchData
+	}
+
+	nc := newCountMinSketch(width, depth, int(k))
+	for i := range nc.counts {
+		nc.counts[i] = binary.BigEndian.Uint64(data[8*i:])
+	}
+	data = data[ncounts*8:]
+
+	for i := uint32(0); i < ntop; i++ {
+		if len(data) < 4 {
+			return ErrSketchData
+		}
+		n := binary.BigEndian.Uint32(data)
+		if uint64(len(data)-4) < uint64(n) {
+			return ErrSketchData
+		}
+		s := string(data[4 : 4+n])
+		data = data[4+n:]
+		nc.track(s, nc.Estimate(s))
+	}
+	if len(data) != 0 {
+		return ErrSketchData
+	}
+
+	nc.total = total
+	*cm = *nc
+
+	return nil
+}
+
+// hitter is a heavy hitter candidate in a hitterHeap.
+type hitter struct {
+	value string
+	count uint64
+	index int
+}
+
+// hitterHeap is a min-heap of heavy hitters by count, implementing heap.Interface.
+type hitterHeap []*hitter
+
+func (hh hitterHeap) Len() int { return len(hh) }
+
+func (hh hitterHeap) Less(i, j int) bool { return hh[i].count < hh[j].count }
+
+func (hh hitterHeap) Swap(i, j int) {
+	hh[i], hh[j] = hh[j], hh[i]
+	hh[i].index, hh[j].index = i, j
+}
+
+func (hh *hitterHeap) Push(x interface{}) {
+	h := x.(*hitter)
+	h.index = len(*hh)
+	*hh = append(*hh, h)
+}
+
+func (hh *hitterHeap) Pop() interface{} {
+	old := *hh
+	h := old[len(old)-1]
+	*hh = old[:len(old)-1]
+
+	return h
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	for _, n := range []int{0, 10, 1000, 100000} {
+		a := make([]string, 0, 2*n)
+		for i := 0; i < n; i++ {
+			s := fmt.Sprintf("user-%d", i)
+			a = append(a, s, s)
+		}
+
+		h := NewHyperLogLog(14)
+		h.AddAll(a)
+
+		got, want := float64(h.Count()), float64(len(Unique(append([]string(nil), a...))))
+		if math.Abs(got-want) > 0.03*want+1 {
+			t.Errorf("n=%d: Count() = %v, want about %v", n, got, want)
+		}
+	}
+}
+
+func TestHyperLogLogMerge(t *testing.T) {
+	h1, h2, all := NewHyperLogLog(12), NewHyperLogLog(12), NewHyperLogLog(12)
+	for i := 0; i < 20000; i++ {
+		s := fmt.Sprint(i)
+		all.Add(s)
+		if i%2 == 0 {
+			h1.Add(s)
+		} else {
+			h2.Add(s)
+		}
+	}
+
+	if err := h1.Merge(h2); err != nil {
+		t.Fatal(err)
+	}
+	if h1.Count() != all.Count() {
+		t.Errorf("merged Count() = %v, want %v", h1.Count(), all.Count())
+	}
+	if err := h1.Merge(NewHyperLogLog(10)); err != ErrSketchMismatch {
+		t.Errorf("Merge() error = %v, want %v", err, ErrSketchMismatch)
+	}
+
+	data, _ := h1.MarshalBinary()
+	var h3 HyperLogLog
+	if err := h3.UnmarshalBinary(data); err != nil || h3.Count() != h1.Count() {
+		t.Errorf("UnmarshalBinary() = %v, Count() = %v, want %v", err, h3.Count(), h1.Count())
+	}
+	if err := h3.UnmarshalBinary(data[:len(data)-1]); err != ErrSketchData {
+		t.Errorf("UnmarshalBinary() error = %v, want %v", err, ErrSketchData)
+	}
+}
+
+func TestHyperLogLogAddSeq(t *testing.T) {
+	h := NewHyperLogLog(10)
+	h.AddSeq(func(yield func(string) bool) {
+		for _, s := range []string{"a", "b", "a", "c"} {
+			if !yield(s) {
+				return
+			}
+		}
+	})
+	if h.Count() != 3 {
+		t.Errorf("Count() = %v, want 3", h.Count())
+	}
+}
+
+// zipf returns a stream where value "v<i>" appears 1000/(i+1) times.
+func zipf(n int) []string {
+	var a []string
+	for i := 0; i < n; i++ {
+		for j := 0; j < 1000/(i+1); j++ {
+			a = append(a, fmt.Sprintf("v%d", i))
+		}
+	}
+
+	return Shuffle(a)
+}
+
+func TestCountMinSketch(t *testing.T) {
+	a := zipf(200)
+	cm := NewCountMinSketch(0.001, 0.01, 5)
+	cm.AddAll(a)
+
+	if cm.Total() != uint64(len(a)) {
+		t.Errorf("Total() = %v, want %v", cm.Total(), len(a))
+	}
+
+	bound := uint64(0.001 * float64(len(a)))
+	for i := 0; i < 200; i += 17 {
+		s := fmt.Sprintf("v%d", i)
+		exact := uint64(Count(a, s))
+		if got := cm.Estimate(s); got < exact || got > exact+bound {
+			t.Errorf("Estimate(%q) = %v, want %v..%v", s, got, exact, exact+bound)
+		}
+	}
+	if got := cm.Estimate("missing"); got > bound {
+		t.Errorf("Estimate(missing) = %v", got)
+	}
+
+	want := []HeavyHitter{{"v0", 1000}, {"v1", 500}, {"v2", 333}, {"v3", 250}, {"v4", 200}}
+	got := cm.TopK()
+	if len(got) != len(want) {
+		t.Fatalf("TopK() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Value != want[i].Value || got[i].Count < want[i].Count || got[i].Count > want[i].Count+bound {
+			t.Errorf("TopK()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCountMinSketchMerge(t *testing.T) {
+	a := zipf(100)
+	half := len(a) / 2
+
+	cm1, cm2 := NewCountMinSketch(0.001, 0.01, 3), NewCountMinSketch(0.001, 0.01, 3)
+	cm1.AddAll(a[:half])
+	cm2.AddSeq(func(yield func(string) bool) {
+		for _, s := range a[half:] {
+			if !yield(s) {
+				return
+			}
+		}
+	})
+
+	if err := cm1.Merge(cm2); err != nil {
+		t.Fatal(err)
+	}
+	if cm1.Total() != uint64(len(a)) {
+		t.Errorf("Total() = %v, want %v", cm1.Total(), len(a))
+	}
+	if top := cm1.TopK(); len(top) != 3 || top[0].Value != "v0" || top[1].Value != "v1" || top[2].Value != "v2" {
+		t.Errorf("TopK() = %v", top)
+	}
+	if err := cm1.Merge(NewCountMinSketch(0.01, 0.01, 3)); err != ErrSketchMismatch {
+		t.Errorf("Merge() error = %v, want %v", err, ErrSketchMismatch)
+	}
+
+	data, err := cm1.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cm3 CountMinSketch
+	if err := cm3.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if cm3.Total() != cm1.Total() || cm3.Estimate("v7") != cm1.Estimate("v7") {
+		t.Error("unmarshaled sketch differs")
+	}
+	if got, want := cm3.TopK(), cm1.TopK(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("unmarshaled TopK() = %v, want %v", got, want)
+	}
+	// A 1x1 sketch that tracks 2^31-1 heavy hitters.
+	manyTop := appendUint32(appendUint32([]byte(cmsMagic), 1), 1)
+	manyTop = appendUint32(appendUint32(appendUint64(manyTop, 0), 1<<31-1), 0)
+	manyTop = appendUint64(manyTop, 0)
+
+	for _, bad := range [][]byte{nil, data[:len(data)-1], append(data, 0), manyTop} {
+		if err := cm3.UnmarshalBinary(bad); err != ErrSketchData {
+			t.Errorf("UnmarshalBinary() error = %v, want %v", err, ErrSketchData)
+		}
+	}
+}
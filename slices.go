@@ -8,42 +8,172 @@ package slices
 
 import (
 	"math/rand"
+	"strconv"
 	"strings"
 )
 
 // ValueFunc is a value comparison func, used to compare element values in a slice.
 type ValueFunc func(v string) bool
 
-// Compare returns an integer comparing two slices lexicographically.
-// The result will be 0 if a==b, or that a has all values of b.
-// The result will be -1 if a < b, or a is shorter than b.
-// The result will be +1 if a > b, or a is longer than b.
+// And returns a ValueFunc that is true when f and all funcs in g are true.
+func (f ValueFunc) And(g ...ValueFunc) ValueFunc {
+	return func(v string) bool {
+		if !f(v) {
+			return false
+		}
+		for i := range g {
+			if !g[i](v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Not returns a ValueFunc that negates f.
+func (f ValueFunc) Not() ValueFunc {
+	return func(v string) bool {
+		return !f(v)
+	}
+}
+
+// Or returns a ValueFunc that is true when f or any func in g is true.
+func (f ValueFunc) Or(g ...ValueFunc) ValueFunc {
+	return func(v string) bool {
+		if f(v) {
+			return true
+		}
+		for i := range g {
+			if g[i](v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Xor returns a ValueFunc that is true when exactly one of f and g is true.
+func (f ValueFunc) Xor(g ValueFunc) ValueFunc {
+	return func(v string) bool {
+		return f(v) != g(v)
+	}
+}
+
+// AddPrefix returns a new slice with prefix prepended to each element of a.
+func AddPrefix(a []string, prefix string) []string {
+	return Map(func(v string) string { return prefix + v }, a)
+}
+
+// AddSuffix returns a new slice with suffix appended to each element of a.
+func AddSuffix(a []string, suffix string) []string {
+	return Map(func(v string) string { return v + suffix }, a)
+}
+
+// ApplyReplace returns a new slice with strings.Replace(v, old, new, n) applied
+// to each element v of a.
+// Unlike Replace, which replaces whole elements, this replaces within each element.
+func ApplyReplace(a []string, old, new string, n int) []string {
+	return Map(func(v string) string { return strings.Replace(v, old, new, n) }, a)
+}
+
+// ApplyToLower returns a new slice with each element of a mapped to lower case.
+func ApplyToLower(a []string) []string {
+	return Map(strings.ToLower, a)
+}
+
+// ApplyToUpper returns a new slice with each element of a mapped to upper case.
+func ApplyToUpper(a []string) []string {
+	return Map(strings.ToUpper, a)
+}
+
+// ApplyTrimPrefix returns a new slice with prefix removed from the start of each element of a.
+// Unlike TrimPrefix, which removes whole elements, this trims within each element.
+func ApplyTrimPrefix(a []string, prefix string) []string {
+	return Map(func(v string) string { return strings.TrimPrefix(v, prefix) }, a)
+}
+
+// ApplyTrimSpace returns a new slice with leading and trailing white space removed
+// from each element of a.
+func ApplyTrimSpace(a []string) []string {
+	return Map(strings.TrimSpace, a)
+}
+
+// ApplyTrimSuffix returns a new slice with suffix removed from the end of each element of a.
+// Unlike TrimSuffix, which removes whole elements, this trims within each element.
+func ApplyTrimSuffix(a []string, suffix string) []string {
+	return Map(func(v string) string { return strings.TrimSuffix(v, suffix) }, a)
+}
+
+// All returns true if f(s) is true for every element in a, or if a is empty.
+func All(a []string, f ValueFunc) bool {
+	return IndexFunc(a, f.Not()) == -1
+}
+
+// Any returns true if f(s) is true for any element in a, false otherwise.
+func Any(a []string, f ValueFunc) bool {
+	return IndexFunc(a, f) != -1
+}
+
+// Cmp returns an integer comparing two slices lexicographically.
+// The elements are compared in order with strings.Compare, and the first
+// non-matching element decides the result. If one slice is a prefix of the
+// other, the shorter slice is the lesser.
+// The result will be 0 if a == b, -1 if a < b, and +1 if a > b.
 // A nil argument is equivalent to an empty slice.
+func Cmp(a, b []string) int {
+	return CmpFunc(a, b, strings.Compare)
+}
+
+// CmpFunc is like Cmp, but compares the elements with the three-way comparison
+// func cmp, which returns a negative number, zero or a positive number.
+// The result is always -1, 0 or +1.
+func CmpFunc(a, b []string, cmp func(string, string) int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		switch c := cmp(a[i], b[i]); {
+		case c < 0:
+			return -1
+		case c > 0:
+			return +1
+		}
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return +1
+	}
+
+	return 0
+}
+
+// Compare returns an integer comparing two slices.
+// The result is len(a) if b is empty, -len(b) if a is empty, and otherwise
+// the length of the common prefix of a and b minus len(b). So the result is 0
+// if a == b or b is a prefix of a, and negative otherwise; the order of the
+// differing elements is not compared.
+// A nil argument is equivalent to an empty slice.
+//
+// Deprecated: Compare is not a lexicographic comparison. Use Cmp, which
+// returns -1, 0 or +1 consistent with strings.Compare, or Equal and Mismatch.
 func Compare(a, b []string) int {
 	return CompareFunc(a, b, func(v1, v2 string) bool { return v1 == v2 })
 }
 
-// CompareFunc returns an integer comparing two slices with func f.
+// CompareFunc is like Compare, but elements are equal when f returns true.
+//
+// Deprecated: CompareFunc is not a lexicographic comparison. Use CmpFunc with a
+// three-way comparison func, or MismatchFunc.
 func CompareFunc(a, b []string, f func(string, string) bool) int {
-	var i int
-
 	m, n := len(a), len(b)
 	switch {
 	case m == 0:
 		return -n
 	case n == 0:
 		return m
-	case m > n:
-		m = n
 	}
 
-	for i = 0; i < m; i++ {
-		if !f(a[i], b[i]) {
-			break
-		}
-	}
-
-	return i - n
+	return prefixLen(a, b, f) - n
 }
 
 // Contains returns true if s is in a, false otherwise
@@ -83,8 +213,25 @@ func Count(a []string, s string) int {
 	return n
 }
 
+// CountFunc returns the number of elements in a where f(s) == true.
+func CountFunc(a []string, f ValueFunc) int {
+	var n int
+
+	for i := range a {
+		if f(a[i]) {
+			n++
+		}
+	}
+
+	return n
+}
+
 // Diff returns a slice with all the elements of a that are not found in b.
-func Diff(a, b []string) []string {
+func Diff(a, b []string) (res []string) {
+	if auditEnabled {
+		defer audit("Diff", a, b)(&res)
+	}
+
 	if len(a) == 0 {
 		return nil
 	}
@@ -97,7 +244,7 @@ func Diff(a, b []string) []string {
 		set[v] = struct{}{}
 	}
 
-	res := make([]string, 0, len(a))
+	res = make([]string, 0, len(a))
 	for _, v := range a {
 		if _, ok := set[v]; !ok {
 			res = append(res, v)
@@ -111,9 +258,28 @@ func Diff(a, b []string) []string {
 	return res
 }
 
+// DiffIndices returns the indexes of the elements of a that differ from the element
+// of b at the same index, including the indexes of a past the end of b.
+// It returns nil if there are none.
+func DiffIndices(a, b []string) []int {
+	var idx []int
+
+	for i := range a {
+		if i >= len(b) || a[i] != b[i] {
+			idx = append(idx, i)
+		}
+	}
+
+	return idx
+}
+
 // DiffFunc compares the elements of a against the lookup derived from b using f.
 // It returns a slice of the elements in a where f returns true.
-func DiffFunc(a, b []string, f func(map[string]struct{}, string) bool) []string {
+func DiffFunc(a, b []string, f func(map[string]struct{}, string) bool) (res []string) {
+	if auditEnabled {
+		defer audit("DiffFunc", a, b)(&res)
+	}
+
 	if len(a) == 0 || f == nil {
 		return nil
 	}
@@ -123,7 +289,7 @@ func DiffFunc(a, b []string, f func(map[string]struct{}, string) bool) []string
 		set[v] = struct{}{}
 	}
 
-	res := make([]string, 0, len(a))
+	res = make([]string, 0, len(a))
 	for _, v := range a {
 		if f(set, v) {
 			res = append(res, v)
@@ -140,13 +306,13 @@ func DiffFunc(a, b []string, f func(map[string]struct{}, string) bool) []string
 // Equal returns a boolean reporting whether a and b are the same length and contain the
 // same values, when compared lexicographically.
 func Equal(a, b []string) bool {
-	return len(a) == len(b) && Compare(a, b) == 0
+	return len(a) == len(b) && Mismatch(a, b) == -1
 }
 
 // EqualFold returns a boolean reporting whether a and b
 // are the same length and their values are equal under Unicode case-folding.
 func EqualFold(a, b []string) bool {
-	return len(a) == len(b) && CompareFunc(a, b, strings.EqualFold) == 0
+	return len(a) == len(b) && MismatchFunc(a, b, strings.EqualFold) == -1
 }
 
 // Fill is an alias of Repeat.
@@ -161,7 +327,11 @@ func Filter(a []string, s string) []string {
 
 // FilterFunc returns a slice with all the elements of a that match string s that
 // satisfy f(s). If func f returns true, the value will be filtered from b.
-func FilterFunc(a []string, f ValueFunc) []string {
+func FilterFunc(a []string, f ValueFunc) (b []string) {
+	if auditEnabled {
+		defer audit("FilterFunc", a)(&b)
+	}
+
 	if f == nil {
 		return nil
 	}
@@ -170,7 +340,7 @@ func FilterFunc(a []string, f ValueFunc) []string {
 		return nil
 	}
 
-	b := make([]string, 0, len(a))
+	b = make([]string, 0, len(a))
 
 	for i := range a {
 		if f(a[i]) {
@@ -197,6 +367,8 @@ func FilterSuffix(a []string, suffix string) []string {
 
 // Chunk will divide a slice into subslices with size elements into a new 2d slice.
 // The last chunk may contain less than size elements. If size less than 1, Chunk returns nil.
+// The chunks share the backing array of a, with their capacity limited so that appending
+// to a chunk doesn't overwrite the next one.
 func Chunk(a []string, size int) [][]string {
 	if size < 1 {
 		return nil
@@ -208,7 +380,7 @@ func Chunk(a []string, size int) [][]string {
 	}
 
 	if len(a) > 0 {
-		aa = append(aa, a)
+		aa = append(aa, a[:len(a):len(a)])
 	}
 
 	return aa
@@ -260,17 +432,14 @@ func Intersect(a, b []string) []string {
 }
 
 // InsertAt inserts the values in slice a at index idx.
-// This func will append the values if idx doesn't fit in the slice or is negative.
+// This func will append the values if idx doesn't fit in the slice or is -1, and
+// prepend them if idx is any other negative number.
+//
+// If the result fits in the capacity of a, InsertAt reuses its backing array and
+// a is modified. Otherwise the result is a new slice.
 func InsertAt(a []string, idx int, values ...string) []string {
 	m, n := len(a), len(values)
-	switch {
-	case idx == -1:
-		idx = m
-	case idx < 0:
-		idx = 0
-	case idx > m:
-		idx = m
-	}
+	idx = insertIndex(m, idx)
 
 	if size := m + n; size <= cap(a) {
 		b := a[:size]
@@ -288,6 +457,20 @@ func InsertAt(a []string, idx int, values ...string) []string {
 	return b
 }
 
+// insertIndex returns the index in a slice of length m where InsertAt inserts for idx.
+func insertIndex(m, idx int) int {
+	switch {
+	case idx == -1:
+		return m
+	case idx < 0:
+		return 0
+	case idx > m:
+		return m
+	}
+
+	return idx
+}
+
 // LastIndex returns the index of the last instance of s in a, or -1 if not found
 func LastIndex(a []string, s string) int {
 	return LastIndexFunc(a, ValueEquals(s))
@@ -331,13 +514,18 @@ func LastSearch(a []string, substr string) int {
 	return LastIndexFunc(a, ValueContains(substr))
 }
 
-// Map returns a new slice with the function 'mapping' applied to each element of b
-func Map(mapping func(string) string, a []string) []string {
+// Map returns a new slice with the function 'mapping' applied to each element of a.
+// If mapping is nil, Map returns a itself.
+func Map(mapping func(string) string, a []string) (b []string) {
 	if mapping == nil {
 		return a
 	}
 
-	b := make([]string, len(a))
+	if auditEnabled {
+		defer audit("Map", a)(&b)
+	}
+
+	b = make([]string, len(a))
 
 	for i := range a {
 		b[i] = mapping(a[i])
@@ -347,7 +535,11 @@ func Map(mapping func(string) string, a []string) []string {
 }
 
 // Merge combines zero or many slices together, while preserving the order of elements.
-func Merge(aa ...[]string) []string {
+func Merge(aa ...[]string) (res []string) {
+	if auditEnabled {
+		defer audit("Merge", aa...)(&res)
+	}
+
 	total := 0
 	for _, s := range aa {
 		total += len(s)
@@ -364,6 +556,28 @@ func Merge(aa ...[]string) []string {
 	return a
 }
 
+// None returns true if f(s) is false for every element in a, or if a is empty.
+func None(a []string, f ValueFunc) bool {
+	return IndexFunc(a, f) == -1
+}
+
+// Mismatch returns the index of the first element that differs between a and b,
+// or -1 if they are equal. If one slice is a prefix of the other, the length of
+// the shorter one is returned.
+func Mismatch(a, b []string) int {
+	return MismatchFunc(a, b, func(v1, v2 string) bool { return v1 == v2 })
+}
+
+// MismatchFunc is like Mismatch, but elements are equal when f returns true.
+func MismatchFunc(a, b []string, f func(string, string) bool) int {
+	i := prefixLen(a, b, f)
+	if i == len(a) && i == len(b) {
+		return -1
+	}
+
+	return i
+}
+
 // Pop removes the last element in a and returns it, shortening the slice by one.
 // If a is empty returns empty string "".
 // Note that this function will change the slice pointed by a.
@@ -387,6 +601,29 @@ func Push(a *[]string, values ...string) int {
 	return len(*a)
 }
 
+// prefixLen returns the length of the common prefix of a and b, where elements
+// are equal when f returns true.
+func prefixLen(a, b []string, f func(string, string) bool) int {
+	m := len(a)
+	if n := len(b); n < m {
+		m = n
+	}
+
+	for i := 0; i < m; i++ {
+		if !f(a[i], b[i]) {
+			return i
+		}
+	}
+
+	return m
+}
+
+// Quote returns a new slice with each element of a as a double-quoted Go string
+// literal, using strconv.Quote.
+func Quote(a []string) []string {
+	return Map(strconv.Quote, a)
+}
+
 // Reduce applies the f func to each element in a and aggregates the result in acc
 // and returns the total of the iterations. If there is only one value in the slice,
 // it is returned.
@@ -431,30 +668,17 @@ func RepeatFunc(f func() string, count int) []string {
 
 // Replace returns a copy of the slice a with the first n instances of old replaced by new.
 // If n < 0, there is no limit on the number of replacements.
-func Replace(a []string, old, new string, n int) []string {
-	m := len(a)
-	if old == new || m == 0 || n == 0 {
-		return a
-	}
-
-	if m := Count(a, old); m == 0 {
+// If there is nothing to replace, Replace returns a itself.
+func Replace(a []string, old, new string, n int) (t []string) {
+	if old == new || n == 0 || !Contains(a, old) {
 		return a
-	} else if n < 0 || m < n {
-		n = m
 	}
 
-	t := append(a[:0:0], a...)
-	for i := 0; i < m; i++ {
-		if n == 0 {
-			break
-		}
-		if t[i] == old {
-			t[i] = new
-			n--
-		}
+	if auditEnabled {
+		defer audit("Replace", a)(&t)
 	}
 
-	return t
+	return ReplaceInPlace(append(a[:0:0], a...), old, new, n)
 }
 
 // ReplaceAll returns a copy of the slice a with all instances of old replaced by new.
@@ -474,7 +698,11 @@ func Rand(a []string, n int) []string {
 
 // RandFunc returns a new slice with n number of random elements of a
 // using func f to select the elements.
-func RandFunc(a []string, n int, f func(int) int) []string {
+func RandFunc(a []string, n int, f func(int) int) (b []string) {
+	if auditEnabled {
+		defer audit("RandFunc", a)(&b)
+	}
+
 	if n < 0 {
 		panic("slices: negative RandFunc count")
 	}
@@ -488,7 +716,7 @@ func RandFunc(a []string, n int, f func(int) int) []string {
 		return []string{}
 	}
 
-	b := make([]string, n)
+	b = make([]string, n)
 	for i := 0; i < n; i++ {
 		idx := f(m)
 		if idx < 0 || idx >= m {
@@ -501,12 +729,9 @@ func RandFunc(a []string, n int, f func(int) int) []string {
 }
 
 // Reverse returns a slice of the reverse index order elements of a.
+// The elements are reversed in place, so a is modified; it is the same as ReverseInPlace.
 func Reverse(a []string) []string {
-	for i, j := 0, len(a)-1; i < j; i, j = i+1, j-1 {
-		a[i], a[j] = a[j], a[i]
-	}
-
-	return a
+	return ReverseInPlace(a)
 }
 
 // Search returns the index of the first element containing substr in a,
@@ -529,123 +754,177 @@ func Shift(a *[]string) string {
 }
 
 // Shuffle returns a slice with randomized order of elements in a.
+// The elements are shuffled in place, so a is modified; it is the same as ShuffleInPlace.
 // Note: You may want initialize the rand seed once in your program.
 //
 //	rand.Seed(time.Now().UnixNano())
 func Shuffle(a []string) []string {
-	if m := len(a); m > 1 {
-		rand.Shuffle(m, func(i, j int) {
-			a[i], a[j] = a[j], a[i]
-		})
-	}
-
-	return a
+	return ShuffleInPlace(a)
 }
 
-// Slice returns a subslice of the elements from the slice a as specified by the offset and length parameters.
+// Slice returns a new slice with the elements of a specified by the offset and length
+// parameters, like PHP's array_slice.
 //
 // If offset > 0 the subslice will start at that offset in the slice.
 // If offset < 0 the subslice will start that far from the end of the slice.
 //
 // If length > 0 then the subslice will have up to that many elements in it.
-// If length == 0 then the subslice will begin from offset up to the end of the slice.
+// If length == 0 then the subslice will begin from offset up to the end of the slice,
+// like a null length in PHP. This differs from PHP, where a length of 0 gives an
+// empty array; there is no other way to leave out the length in Go.
 // If length < 0 then the subslice will stop that many elements from the end of the slice.
 // If the slice a is shorter than the length, then only the available elements will be present.
 //
 // If the offset is larger than the size of the slice, an empty slice is returned.
-func Slice(a []string, offset, length int) []string {
-	m := len(a)
-	if length == 0 {
-		length = m
+// The result never shares memory with a; see SliceView for a variant that does.
+func Slice(a []string, offset, length int) (b []string) {
+	if auditEnabled {
+		defer audit("Slice", a)(&b)
+	}
+
+	b = SliceView(a, offset, length)
+	if b == nil {
+		return nil
+	}
+
+	return append([]string(nil), b...)
+}
+
+// SliceView is like Slice, but returns a subslice of a that shares its backing array.
+// Its capacity is limited, so appending to it doesn't change a.
+func SliceView(a []string, offset, length int) []string {
+	start, end := sliceBounds(len(a), offset, length)
+	if start == end {
+		return nil
 	}
 
+	return a[start:end:end]
+}
+
+// sliceBounds returns the start and end indexes of the portion selected by Slice.
+// If the portion is empty, start == end.
+func sliceBounds(m, offset, length int) (int, int) {
+	start := sliceOffset(m, offset)
+
+	end := m
+	switch {
+	case length > 0 && start+length < m:
+		end = start + length
+	case length < 0:
+		end = m + length
+	}
+
+	if end < start {
+		end = start
+	}
+
+	return start, end
+}
+
+// sliceOffset returns the index in a slice of length m for offset, as used by
+// Slice and Splice. Negative offsets count from the end of the slice, and the
+// result is clamped to 0..m.
+func sliceOffset(m, offset int) int {
 	switch {
 	case offset > m:
-		return nil
-	case offset < 0 && (m+offset) < 0:
-		offset = 0
+		return m
+	case offset < 0 && m+offset < 0:
+		return 0
 	case offset < 0:
-		offset = m + offset
+		return m + offset
 	}
 
+	return offset
+}
+
+// spliceBounds returns the start and end indexes of the portion removed by Splice.
+func spliceBounds(m, offset, length int) (int, int) {
+	start := sliceOffset(m, offset)
+
+	end := start + length
 	switch {
 	case length < 0:
-		length = m - offset + length
-	case offset+length > m:
-		length = m - offset
+		end = m + length
+	case end > m:
+		end = m
 	}
 
-	if length <= 0 {
-		return nil
+	if end < start {
+		end = start
 	}
 
-	return a[offset : offset+length]
+	return start, end
 }
 
-// Splice removes a portion of the slice a and replace it with the elements of another.
+// Splice returns a new slice with a portion of the slice a removed and replaced with the
+// elements of b, like PHP's array_splice.
 //
 // If offset > 0 then the start of the removed portion is at that offset from the beginning of the slice.
 // If offset < 0 then the start of the removed portion is at that offset from the end of the slice.
+// If offset is larger than the size of the slice, the elements of b are appended.
 //
 // If length > 0 then that many elements will be removed.
 // If length == 0 no elements will be removed.
-// If length == size removes everything from offset to the end of slice.
+// If length >= size removes everything from offset to the end of slice, like a null length in PHP.
 // If length < 0 then the end of the removed portion will be that many elements from the end of the slice.
 //
 // If b == nil then length elements are removed from a at offset.
 // If b != nil then the elements are inserted at offset.
-func Splice(a []string, offset, length int, b ...string) []string {
+//
+// The slice a is not modified; see SpliceInPlace for a variant that reuses its backing array.
+func Splice(a []string, offset, length int, b ...string) (r []string) {
+	if auditEnabled {
+		defer audit("Splice", a, b)(&r)
+	}
+
 	m := len(a)
-	switch {
-	case offset > m:
-		return a
-	case offset < 0 && (m+offset) < 0:
-		offset = 0
-	case offset < 0:
-		offset = m + offset
+	start, end := spliceBounds(m, offset, length)
+
+	n := m - (end - start) + len(b)
+	if n == 0 {
+		return nil
 	}
 
-	switch {
-	case length < 0:
-		length = m - offset + length
-	case offset+length > m:
-		length = m - offset
+	r = make([]string, 0, n)
+	r = append(r, a[:start]...)
+	r = append(r, b...)
+
+	return append(r, a[end:]...)
+}
+
+// SpliceInPlace is like Splice, but reuses the backing array of a when the result fits
+// in its capacity. Elements past the end of the result are set to "".
+// Note that this function will change the slice a.
+func SpliceInPlace(a []string, offset, length int, b ...string) []string {
+	m := len(a)
+	start, end := spliceBounds(m, offset, length)
+
+	n := m - (end - start) + len(b)
+	if n > cap(a) {
+		return Splice(a, offset, length, b...)
 	}
 
-	if length < 0 {
-		return a
+	r := a[:n]
+	copy(r[start+len(b):], a[end:m])
+	copy(r[start:], b)
+	if n < m {
+		clearTail(a, n)
 	}
 
-	return append(a[0:offset], append(b, a[offset+length:]...)...)
+	return r
 }
 
 // split works almost like strings.genSplit() but for slices.
 func split(a []string, sep string, n int) [][]string {
-	switch {
-	case n == 0:
-		return nil
-	case sep == "":
+	if sep == "" && n != 0 {
 		return Chunk(a, 1)
-	case n < 0:
-		n = Count(a, sep) + 1
-	}
-
-	aa, i := make([][]string, n+1), 0
-	for i < n {
-		m := Index(a, sep)
-		if m < 0 {
-			break
-		}
-		aa[i] = a[:m]
-		a = a[m+1:]
-		i++
 	}
-	aa[i] = a
 
-	return aa[:i+1]
+	return genSplit(a, ValueEquals(sep), false, n)
 }
 
 // Split divides a slice a into subslices when any element matches the string sep.
+// The subslices share the backing array of a, like Chunk.
 //
 // If a does not contain sep and sep is not empty, Split returns a
 // 2d slice of length 1 whose only element is a.
@@ -658,15 +937,16 @@ func Split(a []string, sep string) [][]string {
 	return split(a, sep, -1)
 }
 
-// Split divides a slice a into subslices when n elements match the string sep.
+// SplitN divides a slice a into subslices when n elements match the string sep.
 //
-// The count determines the number of subslices to return:
+// Unlike strings.SplitN, the count is the number of elements that split the slice:
 //
-//	n > 0: at most n subslices; the last element will be the unsplit remainder.
+//	n > 0: at most n splits, so at most n+1 subslices; the last subslice will be the unsplit remainder.
 //	n == 0: the result is nil (zero subslices)
 //	n < 0: all subslices
 //
-// For other cases, see the documentation for Split.
+// If sep is empty the count is ignored unless it is 0. For other cases, see the
+// documentation for Split.
 func SplitN(a []string, sep string, n int) [][]string {
 	return split(a, sep, n)
 }
@@ -678,16 +958,21 @@ func Trim(a []string, s string) []string {
 
 // TrimFunc returns a slice with all the elements of a that don't match string s that
 // satisfy f(s). If func f returns true, the value will be trimmed from a.
-func TrimFunc(a []string, f ValueFunc) []string {
+// If f is nil, TrimFunc returns a itself.
+func TrimFunc(a []string, f ValueFunc) (b []string) {
 	if f == nil {
 		return a
 	}
 
+	if auditEnabled {
+		defer audit("TrimFunc", a)(&b)
+	}
+
 	if len(a) == 0 {
 		return nil
 	}
 
-	b := make([]string, 0, len(a))
+	b = make([]string, 0, len(a))
 
 	for i := range a {
 		if !f(a[i]) {
@@ -712,7 +997,9 @@ func TrimSuffix(a []string, suffix string) []string {
 	return TrimFunc(a, ValueHasSuffix(suffix))
 }
 
-// Unique returns a slice with duplicate values removed.
+// Unique returns a slice with duplicate values removed, keeping the first instance
+// of each. The result reuses the backing array of a, so a is modified. Unlike
+// UniqueInPlace, the elements of a past the end of the result are left as they are.
 func Unique(a []string) []string {
 	seen := make(map[string]struct{})
 
@@ -727,6 +1014,17 @@ func Unique(a []string) []string {
 	return b
 }
 
+// Unquote returns a new slice with each element of a unquoted using strconv.Unquote.
+// Elements that are not valid quoted strings are left unchanged.
+func Unquote(a []string) []string {
+	return Map(func(v string) string {
+		if s, err := strconv.Unquote(v); err == nil {
+			return s
+		}
+		return v
+	}, a)
+}
+
 // Unshift prepends one or more elements to *a and returns the number of elements.
 // Note that this function will change the slice pointed by a
 func Unshift(a *[]string, s ...string) int {
@@ -765,6 +1063,47 @@ func ValueHasSuffix(suffix string) ValueFunc {
 	}
 }
 
+// ValueIn returns true if element value v is one of the values in set.
+func ValueIn(set []string) ValueFunc {
+	m := make(map[string]struct{}, len(set))
+	for _, v := range set {
+		m[v] = struct{}{}
+	}
+
+	return func(v string) bool {
+		_, ok := m[v]
+		return ok
+	}
+}
+
+// ValueIsEmpty returns true if element value v is the empty string.
+func ValueIsEmpty() ValueFunc {
+	return func(v string) bool {
+		return v == ""
+	}
+}
+
+// ValueLenBetween returns true if the byte length of element value v is
+// between min and max, inclusive.
+func ValueLenBetween(min, max int) ValueFunc {
+	return func(v string) bool {
+		return len(v) >= min && len(v) <= max
+	}
+}
+
+// ValueMatchesGlob returns true if element value v matches the shell pattern.
+// See MatchGlob for the pattern syntax. A malformed pattern matches nothing.
+func ValueMatchesGlob(pattern string) ValueFunc {
+	ps := strings.Split(pattern, "/")
+	if validGlob(ps) != nil {
+		return func(string) bool { return false }
+	}
+
+	return func(v string) bool {
+		return matchGlob(ps, strings.Split(v, "/"))
+	}
+}
+
 // Walk applies the f func to each element in a.
 func Walk(a []string, f func(idx int, val string)) {
 	for idx := range a {
@@ -1,7 +1,11 @@
 package slices
 
 import (
+	"flag"
 	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -77,3 +81,482 @@ func BenchmarkCount3(b *testing.B) {
 			}
 		})
 }
+
+func BenchmarkFilterFunc(b *testing.B) {
+	b.ReportAllocs()
+	f := ValueHasPrefix("x1")
+	for i := 0; i < b.N; i++ {
+		resultSlice = FilterFunc(a100, f)
+	}
+}
+
+func BenchmarkFilterInto(b *testing.B) {
+	b.ReportAllocs()
+	f := ValueHasPrefix("x1")
+	dst := make([]string, 0, len(a100))
+	for i := 0; i < b.N; i++ {
+		resultSlice = FilterInto(dst[:0], a100, f)
+	}
+}
+
+// The benchmarks below run each function over generated inputs of several sizes,
+// string lengths and hit rates. Use -bench.max to skip the larger sizes, and
+// bench/compare.sh to compare the results against the committed baseline.
+//
+//	go test -run '^$' -bench 'Diff|Unique' -bench.max 10000
+
+var benchMax = flag.Int("bench.max", 1000000, "largest input size for the benchmarks")
+
+var (
+	benchSizes   = []int{10, 1000, 100000, 1000000}
+	benchLengths = []int{8, 64}
+	benchHits    = []int{0, 50}
+)
+
+// benchData is a generated benchmark input.
+//
+// The hit rate is the percentage of duplicates in a, of elements of b found in a,
+// and of separators in a. The value s is in the middle of a if the hit rate isn't 0.
+type benchData struct {
+	a, b []string
+	s    string
+}
+
+// benchKey returns a value of size bytes for id.
+func benchKey(id, size int) string {
+	k := strconv.Itoa(id)
+	if len(k) >= size {
+		return k
+	}
+
+	return k + strings.Repeat("x", size-len(k))
+}
+
+// newBenchData returns the input with n elements of size bytes, and the hit rate.
+func newBenchData(n, size, hit int) *benchData {
+	d := &benchData{a: make([]string, n), b: make([]string, n), s: benchKey(-1, size)}
+
+	distinct := n - n*hit/100
+	for i := range d.a {
+		d.a[i] = benchKey(i%distinct, size)
+		if i%100 < hit {
+			d.b[i] = benchKey(i%distinct, size)
+		} else {
+			d.b[i] = benchKey(n+i, size)
+		}
+	}
+
+	if hit > 0 {
+		d.a[n/2] = d.s
+	}
+
+	return d
+}
+
+// benchSplitData returns a copy of the elements of d.a, with the hit rate
+// percentage of them replaced by the separator d.s.
+func benchSplitData(d *benchData, hit int) []string {
+	a := append([]string(nil), d.a...)
+	for i := range a {
+		if i%100 < hit/10 {
+			a[i] = d.s
+		}
+	}
+
+	return a
+}
+
+// benchmarkSizes runs f over the inputs of each size, string length and hit rate.
+// If hits is false, only the inputs with hit rate 0 are used.
+func benchmarkSizes(b *testing.B, hits bool, f func(b *testing.B, d *benchData)) {
+	rates := benchHits
+	if !hits {
+		rates = rates[:1]
+	}
+
+	for _, n := range benchSizes {
+		if n > *benchMax {
+			break
+		}
+		for _, size := range benchLengths {
+			for _, hit := range rates {
+				name := fmt.Sprintf("n=%d/len=%d", n, size)
+				if hits {
+					name += fmt.Sprintf("/hit=%d", hit)
+				}
+				b.Run(name, func(b *testing.B) {
+					d := newBenchData(n, size, hit)
+					b.ReportAllocs()
+					b.ResetTimer()
+					f(b, d)
+				})
+			}
+		}
+	}
+}
+
+func BenchmarkSizesContains(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			if Contains(d.a, d.s) {
+				resultInt++
+			}
+		}
+	})
+}
+
+func BenchmarkSizesIndex(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultInt = Index(d.a, d.s)
+		}
+	})
+}
+
+func BenchmarkSizesLastIndex(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultInt = LastIndex(d.a, d.s)
+		}
+	})
+}
+
+func BenchmarkSizesSearch(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultInt = Search(d.a, d.s)
+		}
+	})
+}
+
+func BenchmarkSizesCount(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultInt = Count(d.a, d.s)
+		}
+	})
+}
+
+func BenchmarkSizesDiff(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Diff(d.a, d.b)
+		}
+	})
+}
+
+func BenchmarkSizesIntersect(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Intersect(d.a, d.b)
+		}
+	})
+}
+
+func BenchmarkSizesMultisetDiff(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = MultisetDiff(d.a, d.b)
+		}
+	})
+}
+
+func BenchmarkSizesUnique(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		a := make([]string, len(d.a))
+		for i := 0; i < b.N; i++ {
+			copy(a, d.a)
+			resultSlice = Unique(a)
+		}
+	})
+}
+
+func BenchmarkSizesSplit(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		b.StopTimer()
+		a := benchSplitData(d, 50)
+		b.StartTimer()
+		for i := 0; i < b.N; i++ {
+			resultInt = len(Split(a, d.s))
+		}
+	})
+}
+
+func BenchmarkSizesChunk(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultInt = len(Chunk(d.a, 8))
+		}
+	})
+}
+
+func BenchmarkSizesMerge(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Merge(d.a, d.b)
+		}
+	})
+}
+
+func BenchmarkSizesFilter(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		f := ValueHasPrefix("1")
+		for i := 0; i < b.N; i++ {
+			resultSlice = FilterFunc(d.a, f)
+		}
+	})
+}
+
+func BenchmarkSizesMap(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Map(strings.ToUpper, d.a)
+		}
+	})
+}
+
+func BenchmarkSizesReplace(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = ReplaceAll(d.a, d.s, "")
+		}
+	})
+}
+
+func BenchmarkSizesReverse(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Reverse(d.a)
+		}
+	})
+}
+
+func BenchmarkSizesShuffle(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Shuffle(d.a)
+		}
+	})
+}
+
+func BenchmarkSizesSlice(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Slice(d.a, len(d.a)/4, len(d.a)/2)
+		}
+	})
+}
+
+func BenchmarkSizesSplice(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Splice(d.a, len(d.a)/2, 1, "x", "y")
+		}
+	})
+}
+
+func BenchmarkSizesInsertAt(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = InsertAt(d.a, len(d.a)/2, "x")
+		}
+	})
+}
+
+func BenchmarkSizesMismatch(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		a := append([]string(nil), d.a...)
+		for i := 0; i < b.N; i++ {
+			resultInt = Mismatch(d.a, a)
+		}
+	})
+}
+
+func BenchmarkSizesBag(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultInt = NewBag(d.a).Distinct()
+		}
+	})
+}
+
+func BenchmarkSizesOrderedSet(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultInt = NewOrderedSet(d.a).Len()
+		}
+	})
+}
+
+func BenchmarkSizesStringTable(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultInt = NewStringTable(d.a).Distinct()
+		}
+	})
+}
+
+func BenchmarkSizesVector(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		v := NewVector(d.a)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			resultInt = v.Set(i%len(d.a), "x").InsertAt(len(d.a)/2, "y").Len()
+		}
+	})
+}
+
+func BenchmarkSizesFormatJSON(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultInt = len(FormatJSON(d.a))
+		}
+	})
+}
+
+func BenchmarkSizesTrim(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Trim(d.a, d.s)
+		}
+	})
+}
+
+func BenchmarkSizesRepeat(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Repeat(d.s, len(d.a))
+		}
+	})
+}
+
+func BenchmarkSizesRand(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Rand(d.a, len(d.a)/2)
+		}
+	})
+}
+
+func BenchmarkSizesPad(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = Pad(d.a, -2*len(d.a), d.s)
+		}
+	})
+}
+
+func BenchmarkSizesRange(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		end := strconv.Itoa(len(d.a) - 1)
+		for i := 0; i < b.N; i++ {
+			resultSlice = Range("0", end, 1)
+		}
+	})
+}
+
+func BenchmarkSizesFilterGlob(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = FilterGlob(d.a, "1*x")
+		}
+	})
+}
+
+func BenchmarkSizesFormatCSV(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultInt = len(FormatCSV(d.a))
+		}
+	})
+}
+
+func BenchmarkSizesParseCSV(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		b.StopTimer()
+		s := FormatCSV(d.a)
+		b.StartTimer()
+		for i := 0; i < b.N; i++ {
+			resultSlice, _ = ParseCSV(s)
+		}
+	})
+}
+
+func BenchmarkSizesParseLines(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		b.StopTimer()
+		s := FormatLines(d.a, "\n")
+		b.StartTimer()
+		for i := 0; i < b.N; i++ {
+			resultSlice = ParseLines(s)
+		}
+	})
+}
+
+func BenchmarkSizesStreamFilter(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		b.StopTimer()
+		s := FormatLines(d.a, "\n")
+		f := ValueHasPrefix("1")
+		b.StartTimer()
+		for i := 0; i < b.N; i++ {
+			if err := StreamFilter(ioutil.Discard, NewLineScanner(strings.NewReader(s), 0), f); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkSizesBloomFilter(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultInt = BuildBloomFilter(d.a, 0.01).Len()
+		}
+	})
+}
+
+func BenchmarkSizesCuckooFilter(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			cf, _ := BuildCuckooFilter(d.a, 0.01)
+			resultInt = cf.Len()
+		}
+	})
+}
+
+func BenchmarkSizesHyperLogLog(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			h := NewHyperLogLog(14)
+			h.AddAll(d.a)
+			resultInt = int(h.Count())
+		}
+	})
+}
+
+func BenchmarkSizesCountMinSketch(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			cm := NewCountMinSketch(0.001, 0.01, 10)
+			cm.AddAll(d.a)
+			resultInt = len(cm.TopK())
+		}
+	})
+}
+
+func BenchmarkSizesJoinWith(b *testing.B) {
+	benchmarkSizes(b, false, func(b *testing.B, d *benchData) {
+		aa := Chunk(d.a, 8)
+		for i := 0; i < b.N; i++ {
+			resultSlice = JoinWith(aa, d.s)
+		}
+	})
+}
+
+func BenchmarkSizesSortByKey(b *testing.B) {
+	benchmarkSizes(b, true, func(b *testing.B, d *benchData) {
+		for i := 0; i < b.N; i++ {
+			resultSlice = SortByKey(d.a, strings.ToUpper)
+		}
+	})
+}
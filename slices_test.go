@@ -729,7 +729,7 @@ func TestSplice(t *testing.T) {
 		{name: "nil", args: args{a: nil, b: nil}, want: nil},
 		{name: "a=0,b=1,offset=1,length=0",
 			args: args{a: nil, offset: 1, length: 0, b: []string{"1"}},
-			want: nil},
+			want: []string{"1"}},
 		{name: "a=3,b=2,offset=-1,length=1",
 			args: args{a: slc, offset: -1, length: 1, b: []string{"1", "2"}},
 			want: []string{"a", "b", "1", "2"}},
@@ -741,7 +741,7 @@ func TestSplice(t *testing.T) {
 			want: []string{"a", "c"}},
 		{name: "a=3,b=0,offset=-1,length=1",
 			args: args{a: slc, offset: -1, length: 1, b: nil},
-			want: []string{"a", "c"}},
+			want: []string{"a", "b"}},
 		{name: "a=10,b=3,offset=3,length=3",
 			args: args{a: Repeat("x", 10), offset: 3, length: 3, b: []string{"1", "2", "3"}},
 			want: []string{"x", "x", "x", "1", "2", "3", "x", "x", "x", "x"}},
@@ -787,6 +787,18 @@ func TestUnique(t *testing.T) {
 	}
 }
 
+func TestUniqueTail(t *testing.T) {
+	a := []string{"1", "1", "2", "3"}
+	if got := Unique(a); !Equal(got, []string{"1", "2", "3"}) || a[3] != "3" {
+		t.Errorf("Unique() = %q, leaving %q", got, a)
+	}
+
+	a = []string{"1", "1", "2", "3"}
+	if got := UniqueInPlace(a); !Equal(got, []string{"1", "2", "3"}) || a[3] != "" {
+		t.Errorf("UniqueInPlace() = %q, leaving %q", got, a)
+	}
+}
+
 func TestIndexAny(t *testing.T) {
 	type args struct {
 		a []string
@@ -954,3 +966,168 @@ func TestReduce(t *testing.T) {
 		})
 	}
 }
+
+func TestApply(t *testing.T) {
+	in := []string{" key=Value ", "x-key=Other", `"quoted"`}
+	tests := []struct {
+		name string
+		f    func([]string) []string
+		out  []string
+	}{
+		{name: "AddPrefix", f: func(a []string) []string { return AddPrefix(a, "-") },
+			out: []string{"- key=Value ", "-x-key=Other", `-"quoted"`}},
+		{name: "AddSuffix", f: func(a []string) []string { return AddSuffix(a, "-") },
+			out: []string{" key=Value -", "x-key=Other-", `"quoted"-`}},
+		{name: "ApplyReplace", f: func(a []string) []string { return ApplyReplace(a, "e", "E", 1) },
+			out: []string{" kEy=Value ", "x-kEy=Other", `"quotEd"`}},
+		{name: "ApplyToLower", f: ApplyToLower,
+			out: []string{" key=value ", "x-key=other", `"quoted"`}},
+		{name: "ApplyToUpper", f: ApplyToUpper,
+			out: []string{" KEY=VALUE ", "X-KEY=OTHER", `"QUOTED"`}},
+		{name: "ApplyTrimPrefix", f: func(a []string) []string { return ApplyTrimPrefix(a, "x-") },
+			out: []string{" key=Value ", "key=Other", `"quoted"`}},
+		{name: "ApplyTrimSpace", f: ApplyTrimSpace,
+			out: []string{"key=Value", "x-key=Other", `"quoted"`}},
+		{name: "ApplyTrimSuffix", f: func(a []string) []string { return ApplyTrimSuffix(a, "Other") },
+			out: []string{" key=Value ", "x-key=", `"quoted"`}},
+		{name: "Quote", f: Quote,
+			out: []string{`" key=Value "`, `"x-key=Other"`, `"\"quoted\""`}},
+		{name: "Unquote", f: Unquote,
+			out: []string{" key=Value ", "x-key=Other", "quoted"}},
+		{name: "nil", f: func([]string) []string { return ApplyTrimSpace(nil) },
+			out: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f(in); !Equal(got, tt.out) {
+				t.Errorf("%s() = %q, want %q", tt.name, got, tt.out)
+			}
+		})
+	}
+	if !Equal(in, []string{" key=Value ", "x-key=Other", `"quoted"`}) {
+		t.Errorf("input slice was modified: %q", in)
+	}
+}
+
+func TestValueFuncCombinators(t *testing.T) {
+	in := []string{"apple", "apricot", "axe", "", "banana", "box.go", "main.go"}
+	tests := []struct {
+		name string
+		f    ValueFunc
+		out  []string
+	}{
+		{name: "And", f: ValueHasPrefix("a").And(ValueContains("x").Not()),
+			out: []string{"apple", "apricot"}},
+		{name: "Or", f: ValueHasPrefix("b").Or(ValueIsEmpty()),
+			out: []string{"", "banana", "box.go"}},
+		{name: "Xor", f: ValueHasPrefix("b").Xor(ValueHasSuffix(".go")),
+			out: []string{"banana", "main.go"}},
+		{name: "ValueIn", f: ValueIn([]string{"axe", "kiwi", "main.go"}),
+			out: []string{"axe", "main.go"}},
+		{name: "ValueLenBetween", f: ValueLenBetween(3, 5),
+			out: []string{"apple", "axe"}},
+		{name: "ValueMatchesGlob", f: ValueMatchesGlob("*.go"),
+			out: []string{"box.go", "main.go"}},
+		{name: "ValueMatchesGlob bad", f: ValueMatchesGlob("["),
+			out: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FilterFunc(in, tt.f); !Equal(got, tt.out) {
+				t.Errorf("FilterFunc() = %q, want %q", got, tt.out)
+			}
+			if got := CountFunc(in, tt.f); got != len(tt.out) {
+				t.Errorf("CountFunc() = %v, want %v", got, len(tt.out))
+			}
+		})
+	}
+}
+
+func TestAllAnyNone(t *testing.T) {
+	tests := []struct {
+		name           string
+		in             []string
+		f              ValueFunc
+		all, any, none bool
+	}{
+		{name: "all", in: []string{"a.go", "b.go"}, f: ValueHasSuffix(".go"),
+			all: true, any: true, none: false},
+		{name: "some", in: []string{"a.go", "b.md"}, f: ValueHasSuffix(".go"),
+			all: false, any: true, none: false},
+		{name: "none", in: []string{"a.md", "b.md"}, f: ValueHasSuffix(".go"),
+			all: false, any: false, none: true},
+		{name: "empty", in: nil, f: ValueHasSuffix(".go"),
+			all: true, any: false, none: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := All(tt.in, tt.f); got != tt.all {
+				t.Errorf("All() = %v, want %v", got, tt.all)
+			}
+			if got := Any(tt.in, tt.f); got != tt.any {
+				t.Errorf("Any() = %v, want %v", got, tt.any)
+			}
+			if got := None(tt.in, tt.f); got != tt.none {
+				t.Errorf("None() = %v, want %v", got, tt.none)
+			}
+		})
+	}
+}
+
+func TestMismatch(t *testing.T) {
+	tests := []struct {
+		a, b []string
+		out  int
+	}{
+		{a: nil, b: nil, out: -1},
+		{a: []string{"a"}, b: []string{"a"}, out: -1},
+		{a: []string{"a", "b"}, b: []string{"a", "c"}, out: 1},
+		{a: []string{"a", "b"}, b: []string{"a"}, out: 1},
+		{a: nil, b: []string{"a"}, out: 0},
+		{a: []string{"x", "b"}, b: []string{"a", "b"}, out: 0},
+	}
+	for _, tc := range tests {
+		if out := Mismatch(tc.a, tc.b); out != tc.out {
+			t.Errorf("Mismatch(%q, %q) = %v, want %v", tc.a, tc.b, out, tc.out)
+		}
+	}
+
+	if out := MismatchFunc([]string{"Go", "IS"}, []string{"go", "is", "fun"}, strings.EqualFold); out != 2 {
+		t.Errorf("MismatchFunc() = %v, want 2", out)
+	}
+}
+
+func TestCmp(t *testing.T) {
+	tests := []struct {
+		a, b []string
+		out  int
+	}{
+		{a: nil, b: nil, out: 0},
+		{a: nil, b: []string{}, out: 0},
+		{a: []string{"a", "b"}, b: []string{"a", "b"}, out: 0},
+		{a: []string{"a"}, b: []string{"a", "b"}, out: -1},
+		{a: []string{"a", "b"}, b: []string{"a"}, out: +1},
+		{a: []string{"a", "b"}, b: []string{"a", "c"}, out: -1},
+		{a: []string{"a", "c"}, b: []string{"a", "b", "z"}, out: +1},
+		{a: []string{"B"}, b: []string{"a"}, out: -1},
+		{a: nil, b: []string{""}, out: -1},
+	}
+	for _, tc := range tests {
+		if out := Cmp(tc.a, tc.b); out != tc.out {
+			t.Errorf("Cmp(%q, %q) = %v, want %v", tc.a, tc.b, out, tc.out)
+		}
+		if out := Cmp(tc.b, tc.a); out != -tc.out {
+			t.Errorf("Cmp(%q, %q) = %v, want %v", tc.b, tc.a, out, -tc.out)
+		}
+	}
+
+	byLen := func(v1, v2 string) int { return len(v1) - len(v2) }
+	if out := CmpFunc([]string{"bbb", "a"}, []string{"aaa", "zz"}, byLen); out != -1 {
+		t.Errorf("CmpFunc() = %v, want -1", out)
+	}
+	if out := CmpFunc([]string{"Go"}, []string{"go"}, func(v1, v2 string) int {
+		return strings.Compare(strings.ToLower(v1), strings.ToLower(v2))
+	}); out != 0 {
+		t.Errorf("CmpFunc() = %v, want 0", out)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// Ordering describes how to sort a slice by one or more keys, derived from the
+// elements by key funcs. Each key func is called once per element (the Schwartzian
+// transform), so expensive keys such as parsed versions are not recomputed for
+// every comparison. Elements that compare equal by the first key are ordered by
+// the next, and so on.
+//
+// An Ordering is built with By, ByInt, ByFloat or ByCmp, and extended with the
+// ThenBy methods. Orderings are values: the methods return a new Ordering and
+// leave the original unchanged, so a common prefix can be shared.
+//
+//	// By length, longest first, then in natural order.
+//	ByInt(func(s string) int { return len(s) }).Reverse().ThenByCmp(strings.Compare).Sort(a)
+type Ordering struct {
+	keys   []sortKey
+	stable bool
+}
+
+// sortKey is one key of an Ordering. Func column computes the keys of a and
+// returns a func that compares the keys of elements i and j.
+type sortKey struct {
+	column func(a []string) func(i, j int) int
+	desc   bool
+}
+
+// By returns an Ordering by the string keys key(s), compared lexicographically.
+func By(key func(s string) string) Ordering {
+	return Ordering{}.ThenBy(key)
+}
+
+// ByCmp returns an Ordering by the elements themselves, compared with cmp, which
+// returns a negative number when x < y, a positive number when x > y and zero
+// when they are equal.
+func ByCmp(cmp func(x, y string) int) Ordering {
+	return Ordering{}.ThenByCmp(cmp)
+}
+
+// ByFloat returns an Ordering by the float keys key(s). NaN keys sort first.
+func ByFloat(key func(s string) float64) Ordering {
+	return Ordering{}.ThenByFloat(key)
+}
+
+// ByInt returns an Ordering by the integer keys key(s).
+func ByInt(key func(s string) int) Ordering {
+	return Ordering{}.ThenByInt(key)
+}
+
+// then returns a copy of o with the key k added.
+func (o Ordering) then(k sortKey) Ordering {
+	o.keys = append(o.keys[:len(o.keys):len(o.keys)], k)
+	return o
+}
+
+// ThenBy returns o with the string keys key(s) added as the next key.
+func (o Ordering) ThenBy(key func(s string) string) Ordering {
+	return o.then(sortKey{column: func(a []string) func(i, j int) int {
+		keys := Map(key, a)
+		return func(i, j int) int {
+			return strings.Compare(keys[i], keys[j])
+		}
+	}})
+}
+
+// ThenByCmp returns o with the elements compared by cmp added as the next key.
+func (o Ordering) ThenByCmp(cmp func(x, y string) int) Ordering {
+	return o.then(sortKey{column: func(a []string) func(i, j int) int {
+		return func(i, j int) int {
+			return cmp(a[i], a[j])
+		}
+	}})
+}
+
+// ThenByFloat returns o with the float keys key(s) added as the next key.
+// NaN keys sort first.
+func (o Ordering) ThenByFloat(key func(s string) float64) Ordering {
+	return o.then(sortKey{column: func(a []string) func(i, j int) int {
+		keys := make([]float64, len(a))
+		for i := range a {
+			keys[i] = key(a[i])
+		}
+		return func(i, j int) int {
+			x, y := keys[i], keys[j]
+			switch xnan, ynan := math.IsNaN(x), math.IsNaN(y); {
+			case x < y || (xnan && !ynan):
+				return -1
+			case x > y || (!xnan && ynan):
+				return 1
+			}
+			return 0
+		}
+	}})
+}
+
+// ThenByInt returns o with the integer keys key(s) added as the next key.
+func (o Ordering) ThenByInt(key func(s string) int) Ordering {
+	return o.then(sortKey{column: func(a []string) func(i, j int) int {
+		keys := make([]int, len(a))
+		for i := range a {
+			keys[i] = key(a[i])
+		}
+		return func(i, j int) int {
+			switch x, y := keys[i], keys[j]; {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}})
+}
+
+// Reverse returns o with the last key sorted in descending order.
+// On an Ordering without keys it has no effect.
+func (o Ordering) Reverse() Ordering {
+	if n := len(o.keys); n > 0 {
+		o.keys = append([]sortKey(nil), o.keys...)
+		o.keys[n-1].desc = !o.keys[n-1].desc
+	}
+
+	return o
+}
+
+// Stable returns o with stable sorting: elements that are equal by all the keys
+// keep their original order. Without it, their order is unspecified.
+func (o Ordering) Stable() Ordering {
+	o.stable = true
+	return o
+}
+
+// Sort returns a new slice with the elements of a sorted by o.
+// An Ordering without keys keeps the elements in their original order.
+func (o Ordering) Sort(a []string) []string {
+	if len(a) == 0 || len(o.keys) == 0 {
+		return append(a[:0:0], a...)
+	}
+
+	cmps := make([]func(i, j int) int, len(o.keys))
+	for k := range o.keys {
+		cmps[k] = o.keys[k].column(a)
+	}
+
+	idx := make([]int, len(a))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	less := func(x, y int) bool {
+		i, j := idx[x], idx[y]
+		for k, cmp := range cmps {
+			if c := cmp(i, j); c != 0 {
+				return (c < 0) != o.keys[k].desc
+			}
+		}
+		return false
+	}
+
+	if o.stable {
+		sort.SliceStable(idx, less)
+	} else {
+		sort.Slice(idx, less)
+	}
+
+	b := make([]string, len(a))
+	for i, v := range idx {
+		b[i] = a[v]
+	}
+
+	return b
+}
+
+// SortByKey returns a new slice with the elements of a sorted by the string keys
+// key(s), compared lexicographically. Each key is computed once. The sort is
+// stable. For other key types and several keys, see Ordering.
+//
+//	SortByKey(a, strings.ToLower) // case-insensitive order
+func SortByKey(a []string, key func(s string) string) []string {
+	return By(key).Stable().Sort(a)
+}
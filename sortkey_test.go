@@ -0,0 +1,121 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSortByKey(t *testing.T) {
+	a := []string{"b", "C", "a", "B", "c", "A"}
+	orig := append([]string(nil), a...)
+
+	calls := 0
+	out := SortByKey(a, func(s string) string {
+		calls++
+		return strings.ToLower(s)
+	})
+	if want := []string{"a", "A", "b", "B", "C", "c"}; !Equal(out, want) {
+		t.Errorf("SortByKey() = %q, want %q", out, want)
+	}
+	if calls != len(a) {
+		t.Errorf("key func called %d times, want %d", calls, len(a))
+	}
+	if !Equal(a, orig) {
+		t.Errorf("SortByKey() changed its argument to %q", a)
+	}
+
+	if out := SortByKey(nil, strings.ToLower); out != nil {
+		t.Errorf("SortByKey(nil) = %q", out)
+	}
+}
+
+func TestOrdering(t *testing.T) {
+	length := func(s string) int { return len(s) }
+	version := func(s string) float64 {
+		f, err := strconv.ParseFloat(strings.TrimLeft(s, "vV"), 64)
+		if err != nil {
+			return math.NaN()
+		}
+		return f
+	}
+	words := []string{"pear", "fig", "apple", "kiwi", "date", "banana", "plum"}
+	many := make([]string, 50)
+	for i := range many {
+		many[i] = strconv.Itoa(i * 7 % 50)
+	}
+
+	tests := []struct {
+		name string
+		o    Ordering
+		in   []string
+		out  []string
+	}{
+		{name: "length then natural",
+			o:   ByInt(length).ThenByCmp(strings.Compare),
+			in:  words,
+			out: []string{"fig", "date", "kiwi", "pear", "plum", "apple", "banana"}},
+		{name: "length desc then natural",
+			o:   ByInt(length).Reverse().ThenByCmp(strings.Compare),
+			in:  words,
+			out: []string{"banana", "apple", "date", "kiwi", "pear", "plum", "fig"}},
+		{name: "length then natural desc",
+			o:   ByInt(length).ThenBy(strings.ToLower).Reverse(),
+			in:  words,
+			out: []string{"fig", "plum", "pear", "kiwi", "date", "apple", "banana"}},
+		{name: "stable by length",
+			o:   ByInt(length).Stable(),
+			in:  words,
+			out: []string{"fig", "pear", "kiwi", "date", "plum", "apple", "banana"}},
+		{name: "stable by length desc",
+			o:   ByInt(length).Reverse().Stable(),
+			in:  words,
+			out: []string{"banana", "apple", "pear", "kiwi", "date", "plum", "fig"}},
+		{name: "versions",
+			o:   ByFloat(version).ThenByCmp(strings.Compare),
+			in:  []string{"v1.10", "v1.2", "dev", "v0.9", "V1.2", "beta"},
+			out: []string{"beta", "dev", "v0.9", "v1.10", "V1.2", "v1.2"}},
+		{name: "no keys",
+			o:   Ordering{},
+			in:  many,
+			out: many},
+		{name: "reverse without keys",
+			o:   Ordering{}.Reverse().Stable(),
+			in:  []string{"b", "a"},
+			out: []string{"b", "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.o.Sort(tt.in); !Equal(out, tt.out) {
+				t.Errorf("Sort(%q) = %q, want %q", tt.in, out, tt.out)
+			}
+		})
+	}
+}
+
+func TestOrderingValues(t *testing.T) {
+	base := ByInt(func(s string) int { return len(s) })
+	asc := base.ThenByCmp(strings.Compare)
+	desc := base.ThenByCmp(strings.Compare).Reverse()
+	rev := base.Reverse()
+
+	a := []string{"bb", "a", "aa", "b"}
+	tests := []struct {
+		o   Ordering
+		out []string
+	}{
+		{o: asc, out: []string{"a", "b", "aa", "bb"}},
+		{o: desc, out: []string{"b", "a", "bb", "aa"}},
+		{o: rev.Stable(), out: []string{"bb", "aa", "a", "b"}},
+		{o: base.Stable(), out: []string{"a", "b", "bb", "aa"}},
+	}
+	for i, tc := range tests {
+		if out := tc.o.Sort(a); !Equal(out, tc.out) {
+			t.Errorf("%d: Sort() = %q, want %q", i, out, tc.out)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"strings"
+)
+
+// genSplit splits a into subslices at the elements where f(s) == true, like
+// strings.genSplit. The separators are kept at the end of each subslice if after
+// is true. There are at most n splits, or no limit if n < 0. The subslices share
+// the backing array of a, with their capacity limited.
+func genSplit(a []string, f ValueFunc, after bool, n int) [][]string {
+	if n == 0 {
+		return nil
+	}
+	// Without a limit the result grows as the separators are found, so that f
+	// is called once per element.
+	var aa [][]string
+	if n < 0 || n > len(a) {
+		n = len(a)
+	} else {
+		aa = make([][]string, 0, n+1)
+	}
+
+	keep := 0
+	if after {
+		keep = 1
+	}
+
+	for ; n > 0; n-- {
+		m := IndexFunc(a, f)
+		if m < 0 {
+			break
+		}
+		aa = append(aa, a[:m+keep:m+keep])
+		a = a[m+1:]
+	}
+
+	return append(aa, a[:len(a):len(a)])
+}
+
+// FieldsFunc divides a into subslices at each run of elements where f(s) == true.
+// Unlike SplitFunc, runs of separators count as one and no empty subslices are
+// returned, so separators at the start or end of a are dropped. It returns nil if
+// a has only separators. The subslices share the backing array of a, like Chunk.
+//
+//	FieldsFunc([]string{";", "a", ";", ";", "b"}, ValueEquals(";")) // [["a"] ["b"]]
+func FieldsFunc(a []string, f ValueFunc) [][]string {
+	var aa [][]string
+
+	start := -1
+	for i := range a {
+		switch sep := f(a[i]); {
+		case sep && start >= 0:
+			aa = append(aa, a[start:i:i])
+			start = -1
+		case !sep && start < 0:
+			start = i
+		}
+	}
+
+	if start >= 0 {
+		aa = append(aa, a[start:len(a):len(a)])
+	}
+
+	return aa
+}
+
+// Fields is like FieldsFunc, splitting at the elements that are empty or only white space.
+func Fields(a []string) [][]string {
+	return FieldsFunc(a, func(s string) bool {
+		return strings.TrimSpace(s) == ""
+	})
+}
+
+// FieldsAny is like FieldsFunc, splitting at the elements equal to any of seps.
+func FieldsAny(a []string, seps ...string) [][]string {
+	return FieldsFunc(a, ValueIn(seps))
+}
+
+// SplitAfter is like Split, but each subslice keeps the separator at its end,
+// like strings.SplitAfter.
+//
+//	SplitAfter([]string{"a", ";", "b"}, ";") // [["a" ";"] ["b"]]
+func SplitAfter(a []string, sep string) [][]string {
+	return SplitAfterN(a, sep, -1)
+}
+
+// SplitAfterN is like SplitN, but each subslice keeps the separator at its end.
+func SplitAfterN(a []string, sep string, n int) [][]string {
+	if sep == "" && n != 0 {
+		return Chunk(a, 1)
+	}
+
+	return genSplit(a, ValueEquals(sep), true, n)
+}
+
+// SplitAny is like Split, but splits at the elements equal to any of seps. Unlike
+// Split, an empty string in seps is a separator like any other value.
+// If seps is empty, it returns a 2d slice of length 1 whose only element is a.
+func SplitAny(a []string, seps []string) [][]string {
+	return genSplit(a, ValueIn(seps), false, -1)
+}
+
+// SplitFunc is like Split, but splits at the elements where f(s) == true.
+// If f is nil, it returns a 2d slice of length 1 whose only element is a.
+func SplitFunc(a []string, f ValueFunc) [][]string {
+	if f == nil {
+		f = func(string) bool { return false }
+	}
+
+	return genSplit(a, f, false, -1)
+}
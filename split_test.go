@@ -0,0 +1,97 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitFunc(t *testing.T) {
+	isSep := ValueIn([]string{";", ","})
+
+	tests := []struct {
+		name string
+		out  [][]string
+		want [][]string
+	}{
+		{name: "SplitFunc",
+			out:  SplitFunc([]string{"a", ";", "b", ",", "c"}, isSep),
+			want: [][]string{{"a"}, {"b"}, {"c"}}},
+		{name: "SplitFunc/edges",
+			out:  SplitFunc([]string{";", "a", ";", ";"}, isSep),
+			want: [][]string{{}, {"a"}, {}, {}}},
+		{name: "SplitFunc/nil func",
+			out:  SplitFunc([]string{"a", ";"}, nil),
+			want: [][]string{{"a", ";"}}},
+		{name: "SplitFunc/nil",
+			out:  SplitFunc(nil, isSep),
+			want: [][]string{nil}},
+		{name: "SplitAny",
+			out:  SplitAny([]string{"a", "", "b", "+", "c"}, []string{"", "+"}),
+			want: [][]string{{"a"}, {"b"}, {"c"}}},
+		{name: "SplitAny/no seps",
+			out:  SplitAny([]string{"a", ""}, nil),
+			want: [][]string{{"a", ""}}},
+		{name: "SplitAfter",
+			out:  SplitAfter([]string{"a", ";", "b", ";"}, ";"),
+			want: [][]string{{"a", ";"}, {"b", ";"}, {}}},
+		{name: "SplitAfter/empty sep",
+			out:  SplitAfter([]string{"a", "b"}, ""),
+			want: [][]string{{"a"}, {"b"}}},
+		{name: "SplitAfterN/1",
+			out:  SplitAfterN([]string{"a", ";", "b", ";", "c"}, ";", 1),
+			want: [][]string{{"a", ";"}, {"b", ";", "c"}}},
+		{name: "SplitAfterN/0",
+			out:  SplitAfterN([]string{"a", ";"}, ";", 0),
+			want: nil},
+		{name: "Fields",
+			out:  Fields([]string{"", "a", "b", " ", "\t", "c", ""}),
+			want: [][]string{{"a", "b"}, {"c"}}},
+		{name: "Fields/blank",
+			out:  Fields([]string{"", " "}),
+			want: nil},
+		{name: "FieldsAny",
+			out:  FieldsAny([]string{";", "a", ";", ",", "b", "c", ","}, ";", ","),
+			want: [][]string{{"a"}, {"b", "c"}}},
+		{name: "FieldsFunc",
+			out:  FieldsFunc([]string{"a", "b"}, isSep),
+			want: [][]string{{"a", "b"}}},
+		{name: "FieldsFunc/nil",
+			out:  FieldsFunc(nil, isSep),
+			want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.out) != len(tt.want) {
+				t.Fatalf("got %q, want %q", tt.out, tt.want)
+			}
+			for i := range tt.out {
+				if !Equal(tt.out[i], tt.want[i]) {
+					t.Errorf("got %q, want %q", tt.out, tt.want)
+				}
+			}
+			if tt.want == nil && tt.out != nil {
+				t.Errorf("got %#v, want nil", tt.out)
+			}
+		})
+	}
+
+	a := []string{"a", ";", "b", ",", "c", ";"}
+	calls := 0
+	SplitFunc(a, func(s string) bool { calls++; return isSep(s) })
+	if calls != len(a) {
+		t.Errorf("SplitFunc() called f %d times, want %d", calls, len(a))
+	}
+}
+
+func TestSplitAfterJoin(t *testing.T) {
+	a := []string{"a", ";", ";", "b", ";"}
+	if out := Merge(SplitAfter(a, ";")...); !reflect.DeepEqual(out, a) {
+		t.Errorf("Merge(SplitAfter(%q)) = %q", a, out)
+	}
+	if out := JoinWith(SplitAny(a, []string{";"}), ";"); !reflect.DeepEqual(out, a) {
+		t.Errorf("JoinWith(SplitAny(%q)) = %q", a, out)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"bufio"
+	"io"
+)
+
+// NewLineScanner returns a bufio.Scanner that reads lines from r, accepting
+// lines up to maxLineSize bytes long. If maxLineSize <= 0, bufio.MaxScanTokenSize
+// is used. Longer lines stop the scan with bufio.ErrTooLong.
+//
+// StreamCount takes a *bufio.Scanner, so a scanner with a different split
+// function can be used instead, see ScanNull. The other Stream functions end
+// each value they write with a newline, so they are only suited to line input.
+func NewLineScanner(r io.Reader, maxLineSize int) *bufio.Scanner {
+	if maxLineSize <= 0 {
+		maxLineSize = bufio.MaxScanTokenSize
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, maxLineSize)
+
+	return sc
+}
+
+// ScanNull is a bufio.SplitFunc that returns each NUL-terminated token, with
+// the terminator removed. The last token may be unterminated.
+//
+// Use it to read values that may contain newlines, or to count them with
+// StreamCount. Don't use it with StreamFilter, StreamMap, StreamTrim or
+// StreamUnique: their output is newline-delimited.
+func ScanNull(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	for i := range data {
+		if data[i] == 0 {
+			return i + 1, data[:i], nil
+		}
+	}
+
+	if atEOF {
+		return len(data), data, nil
+	}
+
+	return 0, nil, nil
+}
+
+// streamLines calls f with each token read by sc, and writes the values that f
+// returns with ok == true to w, each followed by a newline. If sc fails, the
+// values written before the failure are kept.
+func streamLines(w io.Writer, sc *bufio.Scanner, f func(string) (string, bool)) error {
+	bw := bufio.NewWriter(w)
+
+	for sc.Scan() {
+		s, ok := f(sc.Text())
+		if !ok {
+			continue
+		}
+		if _, err := bw.WriteString(s); err != nil {
+			return err
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+
+	// Flush what was accepted before a scan error, so the output isn't cut short.
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+
+	return sc.Err()
+}
+
+// StreamCount returns the number of lines read by sc where f(s) == true.
+// It is the streaming equivalent of CountFunc.
+func StreamCount(sc *bufio.Scanner, f ValueFunc) (int, error) {
+	var n int
+
+	for sc.Scan() {
+		if f(sc.Text()) {
+			n++
+		}
+	}
+
+	return n, sc.Err()
+}
+
+// StreamFilter writes to w all the lines read by sc where f(s) == true.
+// It is the streaming equivalent of FilterFunc.
+func StreamFilter(w io.Writer, sc *bufio.Scanner, f ValueFunc) error {
+	return streamLines(w, sc, func(s string) (string, bool) {
+		return s, f(s)
+	})
+}
+
+// StreamMap writes to w each line read by sc with the function mapping applied.
+// It is the streaming equivalent of Map.
+func StreamMap(w io.Writer, sc *bufio.Scanner, mapping func(string) string) error {
+	return streamLines(w, sc, func(s string) (string, bool) {
+		return mapping(s), true
+	})
+}
+
+// StreamTrim writes to w all the lines read by sc where f(s) == false.
+// It is the streaming equivalent of TrimFunc.
+func StreamTrim(w io.Writer, sc *bufio.Scanner, f ValueFunc) error {
+	return streamLines(w, sc, func(s string) (string, bool) {
+		return s, !f(s)
+	})
+}
+
+// StreamUnique writes to w the lines read by sc, with duplicate lines removed.
+// It is the streaming equivalent of Unique.
+//
+// At most maxEntries distinct lines are remembered; when that limit is reached
+// the oldest remembered line is forgotten, so a duplicate that appears more than
+// maxEntries distinct lines after its first occurrence is written again.
+// If maxEntries <= 0 all lines are remembered.
+func StreamUnique(w io.Writer, sc *bufio.Scanner, maxEntries int) error {
+	var (
+		seen = make(map[string]struct{})
+		ring []string
+		next int
+	)
+
+	if maxEntries > 0 {
+		ring = make([]string, 0, maxEntries)
+	}
+
+	return streamLines(w, sc, func(s string) (string, bool) {
+		if _, ok := seen[s]; ok {
+			return s, false
+		}
+		seen[s] = struct{}{}
+
+		switch {
+		case maxEntries <= 0:
+		case len(ring) < maxEntries:
+			ring = append(ring, s)
+		default:
+			delete(seen, ring[next])
+			ring[next] = s
+			next = (next + 1) % maxEntries
+		}
+
+		return s, true
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+)
+
+const streamInput = "GET /a\nPOST /b\nGET /a\n\nGET /c\nPOST /b\n"
+
+func TestStreamFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(*strings.Builder, *bufio.Scanner) error
+		out  string
+	}{
+		{name: "Filter",
+			f: func(w *strings.Builder, sc *bufio.Scanner) error {
+				return StreamFilter(w, sc, ValueHasPrefix("GET"))
+			},
+			out: "GET /a\nGET /a\nGET /c\n"},
+		{name: "Trim",
+			f: func(w *strings.Builder, sc *bufio.Scanner) error {
+				return StreamTrim(w, sc, ValueHasPrefix("GET").Or(ValueIsEmpty()))
+			},
+			out: "POST /b\nPOST /b\n"},
+		{name: "Map",
+			f: func(w *strings.Builder, sc *bufio.Scanner) error {
+				return StreamMap(w, sc, strings.ToLower)
+			},
+			out: strings.ToLower(streamInput)},
+		{name: "Unique",
+			f: func(w *strings.Builder, sc *bufio.Scanner) error {
+				return StreamUnique(w, sc, 0)
+			},
+			out: "GET /a\nPOST /b\n\nGET /c\n"},
+		{name: "Unique bounded",
+			f: func(w *strings.Builder, sc *bufio.Scanner) error {
+				return StreamUnique(w, sc, 2)
+			},
+			out: "GET /a\nPOST /b\n\nGET /c\nPOST /b\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sb strings.Builder
+			sc := NewLineScanner(strings.NewReader(streamInput), 0)
+			if err := tt.f(&sb, sc); err != nil {
+				t.Fatal(err)
+			}
+			if got := sb.String(); got != tt.out {
+				t.Errorf("got %q, want %q", got, tt.out)
+			}
+		})
+	}
+}
+
+func TestStreamMatchesSlices(t *testing.T) {
+	a := ParseLines(streamInput)
+
+	var sb strings.Builder
+	if err := StreamFilter(&sb, NewLineScanner(strings.NewReader(streamInput), 0), ValueContains("/b")); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ParseLines(sb.String()), FilterFunc(a, ValueContains("/b")); !Equal(got, want) {
+		t.Errorf("StreamFilter() = %q, FilterFunc() = %q", got, want)
+	}
+
+	sb.Reset()
+	if err := StreamUnique(&sb, NewLineScanner(strings.NewReader(streamInput), 0), 0); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ParseLines(sb.String()), Unique(append([]string(nil), a...)); !Equal(got, want) {
+		t.Errorf("StreamUnique() = %q, Unique() = %q", got, want)
+	}
+
+	n, err := StreamCount(NewLineScanner(strings.NewReader(streamInput), 0), ValueEquals("GET /a"))
+	if err != nil || n != Count(a, "GET /a") {
+		t.Errorf("StreamCount() = %v, %v; want %v", n, err, Count(a, "GET /a"))
+	}
+}
+
+func TestStreamErrors(t *testing.T) {
+	sc := NewLineScanner(strings.NewReader("short\n"+strings.Repeat("x", 100)+"\n"), 16)
+	n, err := StreamCount(sc, func(string) bool { return true })
+	if n != 1 || !errors.Is(err, bufio.ErrTooLong) {
+		t.Errorf("StreamCount() = %v, %v; want 1, %v", n, err, bufio.ErrTooLong)
+	}
+
+	var sb strings.Builder
+	sc = NewLineScanner(strings.NewReader("a\nb\nc\n"+strings.Repeat("x", 100)), 16)
+	if err := StreamFilter(&sb, sc, func(string) bool { return true }); !errors.Is(err, bufio.ErrTooLong) || sb.String() != "a\nb\nc\n" {
+		t.Errorf("StreamFilter() = %q, %v; want %q, %v", sb.String(), err, "a\nb\nc\n", bufio.ErrTooLong)
+	}
+
+	sc = NewLineScanner(strings.NewReader("a\nb\n"), 0)
+	if err := StreamMap(errWriter{}, sc, strings.ToUpper); err != errWrite {
+		t.Errorf("StreamMap() error = %v, want %v", err, errWrite)
+	}
+}
+
+func TestScanNull(t *testing.T) {
+	sc := bufio.NewScanner(strings.NewReader("a\x00b c\x00\x00d"))
+	sc.Split(ScanNull)
+
+	var a []string
+	for sc.Scan() {
+		a = append(a, sc.Text())
+	}
+	if want := []string{"a", "b c", "", "d"}; !Equal(a, want) {
+		t.Errorf("ScanNull tokens = %q, want %q", a, want)
+	}
+}
+
+var errWrite = errors.New("write failed")
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) { return 0, errWrite }
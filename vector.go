@@ -0,0 +1,256 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+// Vector is an immutable list of strings. Operations that change a Vector return
+// a new version and leave the original unchanged. Versions share most of their
+// memory, so Append, Set, InsertAt, Slice, Splice and Concat take logarithmic time
+// instead of copying every element.
+//
+// A Vector is a balanced tree of small chunks of elements (a rope).
+// The zero value is an empty vector ready to use. A Vector is safe for concurrent
+// use, because it is never modified.
+type Vector struct {
+	root *vnode
+}
+
+// vectorChunk is the largest number of elements in a leaf.
+const vectorChunk = 32
+
+// vnode is a node of a Vector tree. Leaves hold the elements, internal nodes hold
+// the two subtrees. The heights of the subtrees differ by at most one.
+// A nil node is an empty tree. Nodes are never modified after they are made.
+type vnode struct {
+	left, right *vnode
+	leaf        []string
+	size        int
+	height      int
+}
+
+// NewVector returns a Vector with a copy of the elements of a.
+func NewVector(a []string) Vector {
+	return Vector{root: buildVector(a)}
+}
+
+// buildVector returns a balanced tree with a copy of the elements of a.
+func buildVector(a []string) *vnode {
+	if len(a) == 0 {
+		return nil
+	}
+	if len(a) <= vectorChunk {
+		return &vnode{leaf: append([]string(nil), a...), size: len(a)}
+	}
+
+	mid := (len(a) + vectorChunk - 1) / vectorChunk / 2 * vectorChunk
+
+	return vnodeOf(buildVector(a[:mid]), buildVector(a[mid:]))
+}
+
+// vnodeOf returns an internal node with the subtrees l and r.
+func vnodeOf(l, r *vnode) *vnode {
+	h := l.height
+	if r.height > h {
+		h = r.height
+	}
+
+	return &vnode{left: l, right: r, size: l.size + r.size, height: h + 1}
+}
+
+// vbalance returns a tree with the subtrees l and r, rotating if their heights
+// differ by more than one.
+func vbalance(l, r *vnode) *vnode {
+	switch {
+	case l.height > r.height+1:
+		if l.left.height >= l.right.height {
+			return vnodeOf(l.left, vnodeOf(l.right, r))
+		}
+		return vnodeOf(vnodeOf(l.left, l.right.left), vnodeOf(l.right.right, r))
+
+	case r.height > l.height+1:
+		if r.right.height >= r.left.height {
+			return vnodeOf(vnodeOf(l, r.left), r.right)
+		}
+		return vnodeOf(vnodeOf(l, r.left.left), vnodeOf(r.left.right, r.right))
+	}
+
+	return vnodeOf(l, r)
+}
+
+// vjoin returns a balanced tree with the elements of l followed by those of r.
+// Small adjacent leaves are merged.
+func vjoin(l, r *vnode) *vnode {
+	switch {
+	case l == nil:
+		return r
+	case r == nil:
+		return l
+	case l.leaf != nil && r.leaf != nil && l.size+r.size <= vectorChunk:
+		leaf := make([]string, 0, l.size+r.size)
+		leaf = append(append(leaf, l.leaf...), r.leaf...)
+		return &vnode{leaf: leaf, size: len(leaf)}
+	case l.height > r.height+1:
+		return vbalance(l.left, vjoin(l.right, r))
+	case r.height > l.height+1:
+		return vbalance(vjoin(l, r.left), r.right)
+	}
+
+	return vnodeOf(l, r)
+}
+
+// vsplit returns the trees with the first i elements of n and the rest.
+func vsplit(n *vnode, i int) (*vnode, *vnode) {
+	switch {
+	case n == nil || i <= 0:
+		return nil, n
+	case i >= n.size:
+		return n, nil
+	case n.leaf != nil:
+		return &vnode{leaf: n.leaf[:i:i], size: i}, &vnode{leaf: n.leaf[i:], size: n.size - i}
+	case i < n.left.size:
+		l, r := vsplit(n.left, i)
+		return l, vjoin(r, n.right)
+	}
+
+	l, r := vsplit(n.right, i-n.left.size)
+
+	return vjoin(n.left, l), r
+}
+
+// vset returns a copy of the tree n with element i replaced by s.
+func vset(n *vnode, i int, s string) *vnode {
+	if n.leaf != nil {
+		leaf := append([]string(nil), n.leaf...)
+		leaf[i] = s
+		return &vnode{leaf: leaf, size: n.size}
+	}
+
+	if i < n.left.size {
+		return vnodeOf(vset(n.left, i, s), n.right)
+	}
+
+	return vnodeOf(n.left, vset(n.right, i-n.left.size, s))
+}
+
+// each calls f with the leaves of n in order, until f returns false.
+func (n *vnode) each(f func(leaf []string) bool) bool {
+	switch {
+	case n == nil:
+		return true
+	case n.leaf != nil:
+		return f(n.leaf)
+	}
+
+	return n.left.each(f) && n.right.each(f)
+}
+
+// All returns a sequence of the elements of v, in order.
+func (v Vector) All() func(yield func(string) bool) {
+	return func(yield func(string) bool) {
+		v.root.each(func(leaf []string) bool {
+			for _, s := range leaf {
+				if !yield(s) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
+
+// Append returns a new version of v with values added to the end.
+func (v Vector) Append(values ...string) Vector {
+	return Vector{root: vjoin(v.root, buildVector(values))}
+}
+
+// At returns element i of v. It panics if i is out of range.
+func (v Vector) At(i int) string {
+	if i < 0 || i >= v.Len() {
+		panic("slices: Vector index out of range")
+	}
+
+	n := v.root
+	for n.leaf == nil {
+		if i < n.left.size {
+			n = n.left
+		} else {
+			i -= n.left.size
+			n = n.right
+		}
+	}
+
+	return n.leaf[i]
+}
+
+// Concat returns a new version of v with the elements of o added to the end.
+func (v Vector) Concat(o Vector) Vector {
+	return Vector{root: vjoin(v.root, o.root)}
+}
+
+// InsertAt returns a new version of v with values inserted at index idx, like the
+// InsertAt function. If idx is -1 or past the end the values are appended, and if
+// idx is any other negative number they are prepended.
+func (v Vector) InsertAt(idx int, values ...string) Vector {
+	l, r := vsplit(v.root, insertIndex(v.Len(), idx))
+
+	return Vector{root: vjoin(vjoin(l, buildVector(values)), r)}
+}
+
+// Len returns the number of elements in v.
+func (v Vector) Len() int {
+	if v.root == nil {
+		return 0
+	}
+
+	return v.root.size
+}
+
+// Set returns a new version of v with element i replaced by s.
+// It panics if i is out of range.
+func (v Vector) Set(i int, s string) Vector {
+	if i < 0 || i >= v.Len() {
+		panic("slices: Vector index out of range")
+	}
+
+	return Vector{root: vset(v.root, i, s)}
+}
+
+// Slice returns a new version of v with a portion of its elements, selected by
+// offset and length like the Slice function.
+func (v Vector) Slice(offset, length int) Vector {
+	start, end := sliceBounds(v.Len(), offset, length)
+	if start == end {
+		return Vector{}
+	}
+
+	n, _ := vsplit(v.root, end)
+	_, n = vsplit(n, start)
+
+	return Vector{root: n}
+}
+
+// Splice returns a new version of v with a portion of its elements replaced by
+// values. The portion is selected by offset and length like the Splice function.
+func (v Vector) Splice(offset, length int, values ...string) Vector {
+	start, end := spliceBounds(v.Len(), offset, length)
+
+	l, r := vsplit(v.root, start)
+	_, r = vsplit(r, end-start)
+
+	return Vector{root: vjoin(vjoin(l, buildVector(values)), r)}
+}
+
+// Strings returns the elements of v as a new slice.
+func (v Vector) Strings() []string {
+	if v.Len() == 0 {
+		return nil
+	}
+
+	a := make([]string, 0, v.Len())
+	v.root.each(func(leaf []string) bool {
+		a = append(a, leaf...)
+		return true
+	})
+
+	return a
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2025 srfrog - https://srfrog.dev
+// Use of this source code is governed by the license in the LICENSE file.
+
+package slices
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// checkVector reports an error if the tree of v is not balanced, or its sizes are wrong.
+func checkVector(t *testing.T, n *vnode) {
+	t.Helper()
+
+	if n == nil || n.leaf != nil {
+		if n != nil && (n.size != len(n.leaf) || n.size == 0 || n.size > vectorChunk) {
+			t.Fatalf("bad leaf size %d for %d elements", n.size, len(n.leaf))
+		}
+		return
+	}
+
+	checkVector(t, n.left)
+	checkVector(t, n.right)
+
+	if d := n.left.height - n.right.height; d < -1 || d > 1 {
+		t.Fatalf("unbalanced node: heights %d and %d", n.left.height, n.right.height)
+	}
+	if n.size != n.left.size+n.right.size {
+		t.Fatalf("bad node size %d", n.size)
+	}
+}
+
+func TestVector(t *testing.T) {
+	var v Vector
+	if v.Len() != 0 || v.Strings() != nil {
+		t.Fatal("zero Vector is not empty")
+	}
+
+	v1 := v.Append("a", "b", "c")
+	v2 := v1.Set(1, "x")
+	v3 := v2.InsertAt(-1, "d")
+	v4 := v3.InsertAt(0, "z")
+	v5 := v4.Splice(1, 2, "y")
+	v6 := v5.Slice(1, 2)
+	v7 := v6.Concat(v1)
+
+	tests := []struct {
+		v   Vector
+		out []string
+	}{
+		{v: v, out: nil},
+		{v: v1, out: []string{"a", "b", "c"}},
+		{v: v2, out: []string{"a", "x", "c"}},
+		{v: v3, out: []string{"a", "x", "c", "d"}},
+		{v: v4, out: []string{"z", "a", "x", "c", "d"}},
+		{v: v5, out: []string{"z", "y", "c", "d"}},
+		{v: v6, out: []string{"y", "c"}},
+		{v: v7, out: []string{"y", "c", "a", "b", "c"}},
+	}
+	for i, tc := range tests {
+		if out := tc.v.Strings(); !Equal(out, tc.out) {
+			t.Errorf("v%d = %q, want %q", i, out, tc.out)
+		}
+	}
+
+	if s := v7.At(2); s != "a" {
+		t.Errorf("At(2) = %q, want %q", s, "a")
+	}
+
+	var got []string
+	v7.All()(func(s string) bool {
+		got = append(got, s)
+		return len(got) < 3
+	})
+	if !Equal(got, []string{"y", "c", "a"}) {
+		t.Errorf("All() = %q", got)
+	}
+
+	a := []string{"a", "b"}
+	v8 := NewVector(a)
+	a[0] = "x"
+	if v8.At(0) != "a" {
+		t.Error("NewVector() shares memory with its argument")
+	}
+}
+
+func TestVectorPanics(t *testing.T) {
+	v := NewVector([]string{"a"})
+
+	tests := []func(){
+		func() { v.At(1) },
+		func() { v.At(-1) },
+		func() { v.Set(1, "") },
+		func() { Vector{}.At(0) },
+	}
+	for i, f := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("test %d didn't panic", i)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestVectorRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	var (
+		v Vector
+		a []string
+	)
+
+	for i := 0; i < 2000; i++ {
+		prev, old := v, a
+		m := len(a)
+		off, n := rnd.Intn(m+3)-1, rnd.Intn(m+3)-1
+		s := strconv.Itoa(i)
+
+		switch op := rnd.Intn(6); {
+		case op == 0 || m < 10:
+			values := Repeat(s, rnd.Intn(2*vectorChunk))
+			v, a = v.Append(values...), Merge(a, values)
+		case op == 1:
+			idx := rnd.Intn(m)
+			v, a = v.Set(idx, s), Splice(a, idx, 1, s)
+		case op == 2:
+			v, a = v.InsertAt(off, s, s), InsertAt(append([]string(nil), a...), off, s, s)
+		case op == 3:
+			v, a = v.Splice(off, n, s), Splice(a, off, n, s)
+		case op == 4 && m > 200:
+			v, a = v.Slice(off, n), Slice(a, off, n)
+		default:
+			v, a = v.Concat(NewVector(a[:m/4])), Merge(a, a[:m/4])
+		}
+
+		checkVector(t, v.root)
+		if out := v.Strings(); !Equal(out, a) {
+			t.Fatalf("step %d: got %d elements, want %d", i, len(out), len(a))
+		}
+		if out := prev.Strings(); !Equal(out, old) {
+			t.Fatalf("step %d: previous version changed", i)
+		}
+		for j := 0; j < len(a); j += 1 + len(a)/10 {
+			if v.At(j) != a[j] {
+				t.Fatalf("step %d: At(%d) = %q, want %q", i, j, v.At(j), a[j])
+			}
+		}
+	}
+}